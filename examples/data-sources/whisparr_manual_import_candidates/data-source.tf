@@ -0,0 +1,3 @@
+data "whisparr_manual_import_candidates" "example" {
+  download_id = "SABnzbd_nzo_example"
+}
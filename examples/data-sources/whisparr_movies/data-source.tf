@@ -1,2 +1,13 @@
 data "whisparr_movies" "example" {
 }
+
+data "whisparr_movies" "filtered" {
+  monitored = true
+  has_file  = false
+  ids_only  = true
+
+  year_range = {
+    min = 1970
+    max = 1979
+  }
+}
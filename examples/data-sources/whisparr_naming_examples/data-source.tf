@@ -0,0 +1,12 @@
+data "whisparr_naming_examples" "example" {
+  rename_movies         = true
+  standard_movie_format = "{Movie Title} ({Release Year}) {Quality Full}"
+  movie_folder_format   = "{Movie Title} ({Release Year})"
+}
+
+check "naming" {
+  assert {
+    condition     = strcontains(data.whisparr_naming_examples.example.movie_example, "(")
+    error_message = "Movie file format must render the release year."
+  }
+}
@@ -0,0 +1,11 @@
+data "whisparr_parse" "example" {
+  title              = "Movie.Title.2020.1080p.BluRay.x264-GROUP"
+  quality_profile_id = 1
+}
+
+check "bluray" {
+  assert {
+    condition     = data.whisparr_parse.example.source == "bluray"
+    error_message = "BluRay releases must be parsed as bluray source."
+  }
+}
@@ -0,0 +1,14 @@
+data "whisparr_release_score" "example" {
+  quality_profile_id = 1
+  titles = [
+    "Movie.Title.2020.2160p.UHD.BluRay.x265-GROUP",
+    "Movie.Title.2020.1080p.WEB-DL.x264-GROUP",
+  ]
+}
+
+check "prefer_uhd" {
+  assert {
+    condition     = data.whisparr_release_score.example.releases[0].custom_format_score > data.whisparr_release_score.example.releases[1].custom_format_score
+    error_message = "UHD releases must score higher than WEB-DL."
+  }
+}
@@ -0,0 +1,3 @@
+data "whisparr_rename_preview" "example" {
+  movie_ids = [1, 2]
+}
@@ -0,0 +1,3 @@
+data "whisparr_tag_details" "example" {
+  label = "example"
+}
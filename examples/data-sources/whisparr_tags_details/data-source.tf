@@ -0,0 +1,2 @@
+data "whisparr_tags_details" "example" {
+}
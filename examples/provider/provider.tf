@@ -1,4 +1,27 @@
 provider "whisparr" {
-  url     = "http://example.whisparr.tv:8989"
-  api_key = "APIkey-example"
+  url              = "http://example.whisparr.tv:8989"
+  api_key          = "APIkey-example"
+  auto_create_tags = true
+}
+
+provider "whisparr" {
+  alias          = "adopting"
+  url            = "http://example.whisparr.tv:8989"
+  api_key        = "APIkey-example"
+  adopt_existing = true
+}
+
+provider "whisparr" {
+  alias                   = "multi"
+  url                     = "http://example.whisparr.tv:8989"
+  api_key                 = "APIkey-example"
+  cache_list_responses    = true
+  max_concurrent_requests = 2
+  requests_per_second     = 10
+  instances = {
+    "backup" = {
+      url     = "http://backup.whisparr.tv:8989"
+      api_key = "APIkey-backup"
+    }
+  }
 }
@@ -18,4 +18,9 @@ resource "whisparr_custom_format" "example" {
       value          = "31"
     }
   ]
-}
\ No newline at end of file
+}
+
+resource "whisparr_custom_format" "imported" {
+  name = "HDR"
+  json = file("${path.module}/custom_formats/hdr.json")
+}
@@ -6,4 +6,23 @@ resource "whisparr_delay_profile" "example" {
   torrent_delay             = 0
   tags                      = [1, 2]
   preferred_protocol        = "torrent"
-}
\ No newline at end of file
+}
+
+resource "whisparr_delay_profile" "labels" {
+  enable_usenet             = true
+  enable_torrent            = true
+  bypass_if_highest_quality = true
+  usenet_delay              = 0
+  torrent_delay             = 0
+  tag_labels                = ["usenet", "4k"]
+  preferred_protocol        = "usenet"
+}
+
+resource "whisparr_delay_profile" "default" {
+  default_profile    = true
+  enable_usenet      = true
+  enable_torrent     = false
+  usenet_delay       = 60
+  tags               = []
+  preferred_protocol = "usenet"
+}
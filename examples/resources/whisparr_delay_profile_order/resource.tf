@@ -0,0 +1,6 @@
+resource "whisparr_delay_profile_order" "example" {
+  ids = [
+    whisparr_delay_profile.labels.id,
+    whisparr_delay_profile.example.id,
+  ]
+}
@@ -7,4 +7,10 @@ resource "whisparr_download_client_qbittorrent" "example" {
   port           = 9091
   movie_category = "tv-whisparr"
   first_and_last = true
-}
\ No newline at end of file
+}
+
+# Move a generic download client with QBittorrent implementation
+moved {
+  from = whisparr_download_client.qbittorrent
+  to   = whisparr_download_client_qbittorrent.example
+}
@@ -0,0 +1,17 @@
+data "whisparr_manual_import_candidates" "stuck" {
+  folder = "/downloads/complete/Example.Movie.2020"
+}
+
+resource "whisparr_manual_import" "example" {
+  import_mode = "move"
+  files = [
+    for c in data.whisparr_manual_import_candidates.stuck.candidates : {
+      path          = c.path
+      movie_id      = 1
+      quality_id    = c.quality_id
+      languages     = c.languages
+      release_group = c.release_group
+      download_id   = c.download_id
+    }
+  ]
+}
@@ -0,0 +1,15 @@
+resource "whisparr_quality_definitions" "example" {
+  clear_others = true
+
+  definitions = {
+    "Bluray-1080p" = {
+      title          = "Bluray 1080p"
+      min_size       = 30.0
+      max_size       = 300
+      preferred_size = 200
+    }
+    "WEBDL-1080p" = {
+      min_size = 20.0
+    }
+  }
+}
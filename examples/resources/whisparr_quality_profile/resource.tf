@@ -28,4 +28,37 @@ resource "whisparr_quality_profile" "example" {
       ]
     }
   ]
-}
\ No newline at end of file
+}
+
+resource "whisparr_quality_profile" "by_name" {
+  name                 = "example-by-name"
+  upgrade_allowed      = true
+  cutoff_name          = "WEB 2160p"
+  default_format_score = 0
+
+  language = {
+    id   = 1
+    name = "English"
+  }
+
+  qualities = [
+    {
+      name    = "HDTV-2160p"
+      allowed = false
+    },
+    {
+      name    = "WEB 2160p"
+      members = ["WEBDL-2160p", "WEBRip-2160p"]
+    },
+    {
+      name = "Bluray-2160p"
+    }
+  ]
+
+  format_items = [
+    {
+      name  = "x265"
+      score = -100
+    }
+  ]
+}
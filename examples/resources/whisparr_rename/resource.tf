@@ -0,0 +1,3 @@
+resource "whisparr_rename" "example" {
+  movie_ids = [1, 2]
+}
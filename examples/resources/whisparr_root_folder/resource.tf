@@ -1,3 +1,8 @@
 resource "whisparr_root_folder" "example" {
   path = "/tmp"
 }
+
+resource "whisparr_root_folder" "existing" {
+  path           = "/movies"
+  adopt_existing = true
+}
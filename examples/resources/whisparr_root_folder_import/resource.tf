@@ -0,0 +1,15 @@
+resource "whisparr_root_folder" "example" {
+  path = "/movies"
+}
+
+resource "whisparr_root_folder_import" "example" {
+  root_folder_id       = whisparr_root_folder.example.id
+  mode                 = "auto"
+  quality_profile_id   = 1
+  monitored            = true
+  minimum_availability = "released"
+
+  mapping = {
+    "Unmatched Folder (2020)" = 12345
+  }
+}
@@ -1,2 +1,4 @@
 # import using the API/UI ID
-terraform import whisparr_tag.example 10
\ No newline at end of file
+terraform import whisparr_tag.example 10
+# import on a provider instance
+terraform import whisparr_tag.backup 100@backup
@@ -1,3 +1,14 @@
 resource "whisparr_tag" "example" {
   label = "some-value"
 }
+
+resource "whisparr_tag" "protected" {
+  label                   = "protected"
+  prevent_destroy_if_used = true
+}
+
+resource "whisparr_tag" "backup" {
+  provider = whisparr.multi
+  instance = "backup"
+  label    = "some-value"
+}
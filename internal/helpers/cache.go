@@ -0,0 +1,143 @@
+package helpers
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// cachedPaths are the collection endpoints read by many resources in a single run.
+var cachedPaths = map[string]bool{
+	"/api/v3/customformat":      true,
+	"/api/v3/delayprofile":      true,
+	"/api/v3/downloadclient":    true,
+	"/api/v3/exclusions":        true,
+	"/api/v3/importlist":        true,
+	"/api/v3/indexer":           true,
+	"/api/v3/language":          true,
+	"/api/v3/metadata":          true,
+	"/api/v3/movie":             true,
+	"/api/v3/notification":      true,
+	"/api/v3/qualitydefinition": true,
+	"/api/v3/qualityprofile":    true,
+	"/api/v3/remotepathmapping": true,
+	"/api/v3/restriction":       true,
+	"/api/v3/rootfolder":        true,
+	"/api/v3/tag":               true,
+	"/api/v3/tag/detail":        true,
+}
+
+// CacheTransport serves the collection endpoints from memory during a single run.
+// Any request other than GET clears the cache, since it may change the lists.
+type CacheTransport struct {
+	Transport http.RoundTripper
+	responses map[string]*cachedResponse
+	// generation changes on each clear, to drop lists read during a write.
+	generation uint64
+	mu         sync.Mutex
+}
+
+// cachedResponse is the part of a response kept in cache.
+type cachedResponse struct {
+	header http.Header
+	body   []byte
+	status int
+}
+
+// NewCacheTransport wraps the given transport with a list response cache.
+func NewCacheTransport(transport http.RoundTripper) *CacheTransport {
+	return &CacheTransport{
+		Transport: transport,
+		responses: make(map[string]*cachedResponse),
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *CacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		resp, err := t.Transport.RoundTrip(req)
+		t.clear()
+
+		return resp, err
+	}
+
+	if !isList(req) {
+		return t.Transport.RoundTrip(req)
+	}
+
+	key := req.URL.String()
+
+	t.mu.Lock()
+	cached, ok := t.responses[key]
+	generation := t.generation
+	t.mu.Unlock()
+
+	if ok {
+		return cached.response(req), nil
+	}
+
+	resp, err := t.Transport.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	cached = &cachedResponse{
+		header: resp.Header.Clone(),
+		body:   body,
+		status: resp.StatusCode,
+	}
+
+	t.mu.Lock()
+	if generation == t.generation {
+		t.responses[key] = cached
+	}
+	t.mu.Unlock()
+
+	return cached.response(req), nil
+}
+
+func (t *CacheTransport) clear() {
+	t.mu.Lock()
+	t.responses = make(map[string]*cachedResponse)
+	t.generation++
+	t.mu.Unlock()
+}
+
+// isList tells if the request is for one of the cached collection endpoints, without query.
+// The URL base configured in Whisparr may prefix the API path.
+func isList(req *http.Request) bool {
+	if req.URL.RawQuery != "" {
+		return false
+	}
+
+	index := strings.Index(req.URL.Path, "/api/")
+	if index < 0 {
+		return false
+	}
+
+	return cachedPaths[strings.TrimSuffix(req.URL.Path[index:], "/")]
+}
+
+func (c *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        strconv.Itoa(c.status) + " " + http.StatusText(c.status),
+		StatusCode:    c.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        c.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,81 @@
+package helpers
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheTransport(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		requests []string
+		expected int
+	}{
+		"list cached": {
+			requests: []string{"GET /api/v3/tag", "GET /api/v3/tag"},
+			expected: 1,
+		},
+		"id not cached": {
+			requests: []string{"GET /api/v3/tag/1", "GET /api/v3/tag/1"},
+			expected: 2,
+		},
+		"write invalidates": {
+			requests: []string{"GET /api/v3/tag", "POST /api/v3/tag", "GET /api/v3/tag"},
+			expected: 3,
+		},
+		"query not cached": {
+			requests: []string{"GET /api/v3/movie?tmdbId=1", "GET /api/v3/movie?tmdbId=1"},
+			expected: 2,
+		},
+		"singleton not cached": {
+			requests: []string{"GET /api/v3/config/naming", "GET /api/v3/config/naming"},
+			expected: 2,
+		},
+		"one-off lookup not cached": {
+			requests: []string{"GET /api/v3/movie/lookup", "GET /api/v3/movie/lookup"},
+			expected: 2,
+		},
+		"url base": {
+			requests: []string{"GET /whisparr/api/v3/qualityprofile", "GET /whisparr/api/v3/qualityprofile"},
+			expected: 1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			hits := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				hits++
+				_, _ = w.Write([]byte(`[{"id":1}]`))
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: NewCacheTransport(http.DefaultTransport)}
+
+			for _, request := range test.requests {
+				method, path, _ := strings.Cut(request, " ")
+				req, err := http.NewRequest(method, server.URL+path, nil)
+				assert.Nil(t, err)
+
+				resp, err := client.Do(req)
+				assert.Nil(t, err)
+
+				body, err := io.ReadAll(resp.Body)
+				resp.Body.Close()
+				assert.Nil(t, err)
+				assert.Equal(t, `[{"id":1}]`, string(body))
+			}
+
+			assert.Equal(t, test.expected, hits)
+		})
+	}
+}
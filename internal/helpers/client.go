@@ -0,0 +1,53 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Client wraps the Whisparr API client together with the provider level options.
+type Client struct {
+	*whisparr.APIClient
+	// tagLabels caches the tag labels by ID, see TagLabelMap.
+	tagLabels      map[int64]string
+	tagMutex       sync.Mutex
+	AutoCreateTags bool
+	AdoptExisting  bool
+}
+
+// Adopt tells if an existing object must be adopted on create.
+// The resource level override takes precedence over the provider option.
+func (c *Client) Adopt(override types.Bool) bool {
+	if override.IsNull() || override.IsUnknown() {
+		return c.AdoptExisting
+	}
+
+	return override.ValueBool()
+}
+
+// ErrUnknownInstance is returned when an instance is not configured in the provider.
+var ErrUnknownInstance = errors.New("unknown instance")
+
+// Registry holds the clients of the provider instances.
+// The default client is the one configured by the provider url and api_key.
+type Registry struct {
+	Default   *Client
+	Instances map[string]*Client
+}
+
+// Get returns the client for the given instance, the default one if empty.
+func (r *Registry) Get(instance string) (*Client, error) {
+	if instance == "" {
+		return r.Default, nil
+	}
+
+	if client, ok := r.Instances[instance]; ok {
+		return client, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownInstance, instance)
+}
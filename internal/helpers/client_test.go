@@ -0,0 +1,49 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdopt(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		override types.Bool
+		provider bool
+		expected bool
+	}{
+		"provider default": {
+			override: types.BoolNull(),
+			provider: true,
+			expected: true,
+		},
+		"resource disabled": {
+			override: types.BoolValue(false),
+			provider: true,
+			expected: false,
+		},
+		"resource enabled": {
+			override: types.BoolValue(true),
+			provider: false,
+			expected: true,
+		},
+		"unknown": {
+			override: types.BoolUnknown(),
+			provider: false,
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &Client{AdoptExisting: test.provider}
+			assert.Equal(t, test.expected, client.Adopt(test.override))
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/devopsarr/whisparr-go/whisparr"
+)
+
+const (
+	commandPollInterval = time.Second
+	// CommandTimeout is the maximum time to wait for a command to complete.
+	CommandTimeout = 30 * time.Minute
+)
+
+var (
+	ErrCommandFailed  = errors.New("command did not complete")
+	ErrCommandTimeout = errors.New("command timed out")
+)
+
+// SendCommand posts a command with an arbitrary body.
+// It is needed for commands whose payload is not modeled by whisparr.CommandResource (e.g. ManualImport, RenameMovie).
+func (c *Client) SendCommand(ctx context.Context, body any) (*whisparr.CommandResource, error) {
+	command := whisparr.NewCommandResource()
+	if err := c.Request(ctx, http.MethodPost, "/api/v3/command", body, command); err != nil {
+		return nil, err
+	}
+
+	return command, nil
+}
+
+// WaitCommand polls the given command until it reaches a final status or the timeout expires.
+func (c *Client) WaitCommand(ctx context.Context, id int32, timeout time.Duration) (*whisparr.CommandResource, error) {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	for {
+		command, _, err := c.CommandApi.GetCommandById(ctx, id).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		switch command.GetStatus() {
+		case whisparr.COMMANDSTATUS_COMPLETED:
+			return command, nil
+		case whisparr.COMMANDSTATUS_QUEUED, whisparr.COMMANDSTATUS_STARTED:
+		default:
+			return command, fmt.Errorf("%w: %s status %s: %s", ErrCommandFailed, command.GetName(), command.GetStatus(), command.GetMessage())
+		}
+
+		select {
+		case <-ctx.Done():
+			return command, ctx.Err()
+		case <-deadline.C:
+			return command, fmt.Errorf("%w: %s still %s after %s", ErrCommandTimeout, command.GetName(), command.GetStatus(), timeout)
+		case <-time.After(commandPollInterval):
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package helpers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err      error
+		response string
+		status   int
+		expected int32
+	}{
+		"created": {
+			status:   http.StatusCreated,
+			response: `{"id":5,"name":"ManualImport","status":"queued"}`,
+			expected: 5,
+		},
+		"rejected": {
+			status:   http.StatusBadRequest,
+			response: `[{"errorMessage":"invalid"}]`,
+			err:      ErrRequestFailed,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				assert.Equal(t, "/api/v3/command", r.URL.Path)
+				assert.Equal(t, "key", r.Header.Get("X-Api-Key"))
+				assert.JSONEq(t, `{"name":"ManualImport"}`, string(body))
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.response))
+			}))
+			defer server.Close()
+
+			config := whisparr.NewConfiguration()
+			config.AddDefaultHeader("X-Api-Key", "key")
+			config.Servers[0].URL = server.URL
+			client := &Client{APIClient: whisparr.NewAPIClient(config)}
+
+			command, err := client.SendCommand(context.Background(), map[string]string{"name": "ManualImport"})
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, command.GetId())
+		})
+	}
+}
+
+func TestWaitCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err      error
+		response string
+		timeout  time.Duration
+	}{
+		"completed": {
+			response: `{"id":5,"name":"ManualImport","status":"completed"}`,
+			timeout:  time.Second,
+		},
+		"failed": {
+			response: `{"id":5,"name":"ManualImport","status":"failed","message":"error"}`,
+			timeout:  time.Second,
+			err:      ErrCommandFailed,
+		},
+		"timed out": {
+			response: `{"id":5,"name":"ManualImport","status":"started"}`,
+			timeout:  10 * time.Millisecond,
+			err:      ErrCommandTimeout,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v3/command/5", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(test.response))
+			}))
+			defer server.Close()
+
+			config := whisparr.NewConfiguration()
+			config.Servers[0].URL = server.URL
+			client := &Client{APIClient: whisparr.NewAPIClient(config)}
+
+			command, err := client.WaitCommand(context.Background(), 5, test.timeout)
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, whisparr.COMMANDSTATUS_COMPLETED, command.GetStatus())
+		})
+	}
+}
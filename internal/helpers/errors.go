@@ -1,9 +1,19 @@
 package helpers
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
 
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
 // define constant for error management.
@@ -14,6 +24,11 @@ const (
 	Delete                            = "delete"
 	List                              = "list"
 	ClientError                       = "Client Error"
+	UnauthorizedError                 = "Client Error: Unauthorized"
+	NotFoundError                     = "Client Error: Not Found"
+	ConflictError                     = "Client Error: Conflict"
+	ValidationError                   = "Client Error: Validation Failed"
+	ValidationWarning                 = "Client Warning: Validation"
 	ResourceError                     = "Resource Error"
 	DataSourceError                   = "Data Source Error"
 	UnexpectedImportIdentifier        = "Unexpected Import Identifier"
@@ -25,10 +40,177 @@ func ParseNotFoundError(kind, field, search string) string {
 	return fmt.Sprintf("Unable to find %s, got error: data source not found: no %s with %s '%s'", kind, kind, field, search)
 }
 
+// databaseLocked is the message of the SQLite errors caused by concurrent writes.
+const databaseLocked = "database is locked"
+
+// IsDatabaseLocked tells if the response body reports a SQLite lock error.
+func IsDatabaseLocked(body []byte) bool {
+	return bytes.Contains(bytes.ToLower(body), []byte(databaseLocked))
+}
+
 func ParseClientError(action, name string, err error) string {
 	if e, ok := err.(*whisparr.GenericOpenAPIError); ok {
+		if IsDatabaseLocked(e.Body()) {
+			return fmt.Sprintf("Unable to %s %s, got error: %s\nDetails:\n%s\nThe Whisparr database was still locked after retrying, consider lowering the provider max_concurrent_requests.", action, name, err, string(e.Body()))
+		}
+
 		return fmt.Sprintf("Unable to %s %s, got error: %s\nDetails:\n%s", action, name, err, string(e.Body()))
 	}
 
 	return fmt.Sprintf("Unable to %s %s, got error: %s", action, name, err)
 }
+
+// IsNotFound tells if the client call failed with a not found response.
+// The response is the one returned by the SDK Execute, errors of Request carry their own status code.
+func IsNotFound(resp *http.Response, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if resp != nil {
+		return resp.StatusCode == http.StatusNotFound
+	}
+
+	var requestErr *RequestError
+
+	return errors.As(err, &requestErr) && requestErr.StatusCode == http.StatusNotFound
+}
+
+// RemoveNotFound removes the resource from the state when the read error is a not found response,
+// so that terraform plans to create it again. It returns false for any other error.
+func RemoveNotFound(ctx context.Context, name string, resp *http.Response, err error, state *tfsdk.State, diags *diag.Diagnostics) bool {
+	if !IsNotFound(resp, err) {
+		return false
+	}
+
+	diags.AddWarning(NotFoundError, fmt.Sprintf("Unable to read %s, got error: %s\nRemoving it from the state, it was probably deleted outside of terraform.", name, err))
+	state.RemoveResource(ctx)
+
+	return true
+}
+
+// validationFailure is a single item of the Whisparr validation responses.
+type validationFailure struct {
+	AttemptedValue interface{} `json:"attemptedValue"`
+	PropertyName   string      `json:"propertyName"`
+	ErrorMessage   string      `json:"errorMessage"`
+	Severity       string      `json:"severity"`
+	IsWarning      bool        `json:"isWarning"`
+}
+
+// ParseClientDiagnostics turns a client error into diagnostics.
+// Validation responses produce one diagnostic per failure, attached to the related attribute,
+// unauthorized, not found and conflict responses get their own summary.
+func ParseClientDiagnostics(action, name string, err error) diag.Diagnostics {
+	return parseClientDiagnostics(action, name, err, nil)
+}
+
+// ParseFieldClientDiagnostics is ParseClientDiagnostics for the resources based on fields,
+// whose validation failures are mapped to attributes with the field exceptions.
+func ParseFieldClientDiagnostics(action, name string, err error) diag.Diagnostics {
+	return parseClientDiagnostics(action, name, err, getFieldExceptions())
+}
+
+func parseClientDiagnostics(action, name string, err error, exceptions []fieldException) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	e, ok := err.(*whisparr.GenericOpenAPIError)
+	if !ok {
+		diags.AddError(ClientError, ParseClientError(action, name, err))
+
+		return diags
+	}
+
+	switch status := e.Error(); {
+	case strings.HasPrefix(status, "400"):
+		var failures []validationFailure
+		if json.Unmarshal(e.Body(), &failures) == nil && len(failures) > 0 {
+			return parseValidationFailures(action, name, failures, exceptions)
+		}
+	case strings.HasPrefix(status, "401"):
+		diags.AddError(UnauthorizedError, ParseClientError(action, name, err)+"\nCheck the provider url and api_key.")
+
+		return diags
+	case strings.HasPrefix(status, "404"):
+		diags.AddError(NotFoundError, ParseClientError(action, name, err))
+
+		return diags
+	case strings.HasPrefix(status, "409"):
+		diags.AddError(ConflictError, ParseClientError(action, name, err))
+
+		return diags
+	}
+
+	diags.AddError(ClientError, ParseClientError(action, name, err))
+
+	return diags
+}
+
+// parseValidationFailures maps each validation failure to an attribute diagnostic.
+func parseValidationFailures(action, name string, failures []validationFailure, exceptions []fieldException) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, f := range failures {
+		detail := fmt.Sprintf("Unable to %s %s, got error: %s", action, name, f.ErrorMessage)
+		if f.AttemptedValue != nil {
+			detail += fmt.Sprintf(" (attempted value: %v)", f.AttemptedValue)
+		}
+
+		attribute := validationPath(f.PropertyName, exceptions)
+
+		if f.IsWarning || strings.EqualFold(f.Severity, "warning") {
+			diags.AddAttributeWarning(attribute, ValidationWarning, detail)
+		} else {
+			diags.AddAttributeError(attribute, ValidationError, detail)
+		}
+	}
+
+	// The request failed anyway, even if only warnings were returned.
+	if !diags.HasError() {
+		diags.AddError(ValidationError, fmt.Sprintf("Unable to %s %s, got validation warnings only", action, name))
+	}
+
+	return diags
+}
+
+// validationPath converts a Whisparr property name into the attribute path,
+// using the given field exceptions, if any.
+func validationPath(property string, exceptions []fieldException) path.Path {
+	for _, f := range exceptions {
+		if strings.EqualFold(f.apiName, property) {
+			property = f.tfName
+		}
+	}
+
+	// Nested properties are reported on their parent attribute.
+	if i := strings.IndexAny(property, ".["); i >= 0 {
+		property = property[:i]
+	}
+
+	if property == "" {
+		return path.Empty()
+	}
+
+	return path.Root(toSnakeCase(property))
+}
+
+// toSnakeCase converts camel or pascal case API names into terraform names.
+func toSnakeCase(name string) string {
+	var b strings.Builder
+
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// Start a new word unless inside an acronym.
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteRune('_')
+			}
+
+			r = unicode.ToLower(r)
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
@@ -1,10 +1,15 @@
 package helpers
 
 import (
+	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -65,3 +70,191 @@ func TestParseNotFoundError(t *testing.T) {
 		})
 	}
 }
+
+func TestParseClientDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		response string
+		summary  string
+		path     path.Path
+		status   int
+		errors   int
+		warnings int
+	}{
+		"validation": {
+			status:   http.StatusBadRequest,
+			response: `[{"propertyName":"BaseUrl","errorMessage":"Invalid url","attemptedValue":"test","severity":"error"},{"propertyName":"ApiKey","errorMessage":"Unable to connect","isWarning":true}]`,
+			summary:  ValidationError,
+			path:     path.Root("base_url"),
+			errors:   1,
+			warnings: 1,
+		},
+		"warnings only": {
+			status:   http.StatusBadRequest,
+			response: `[{"propertyName":"Host","errorMessage":"Unable to connect","severity":"warning"}]`,
+			summary:  ValidationError,
+			path:     path.Empty(),
+			errors:   1,
+			warnings: 1,
+		},
+		"unauthorized": {
+			status:  http.StatusUnauthorized,
+			summary: UnauthorizedError,
+			path:    path.Empty(),
+			errors:  1,
+		},
+		"not found": {
+			status:  http.StatusNotFound,
+			summary: NotFoundError,
+			path:    path.Empty(),
+			errors:  1,
+		},
+		"conflict": {
+			status:  http.StatusConflict,
+			summary: ConflictError,
+			path:    path.Empty(),
+			errors:  1,
+		},
+		"generic": {
+			status:   http.StatusInternalServerError,
+			response: `{"message":"error"}`,
+			summary:  ClientError,
+			path:     path.Empty(),
+			errors:   1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.response))
+			}))
+			defer server.Close()
+
+			config := whisparr.NewConfiguration()
+			config.Servers[0].URL = server.URL
+			_, _, err := whisparr.NewAPIClient(config).TagApi.CreateTag(context.Background()).TagResource(*whisparr.NewTagResource()).Execute()
+
+			diags := ParseClientDiagnostics(Create, "whisparr_tag", err)
+			assert.Equal(t, test.errors, diags.ErrorsCount())
+			assert.Equal(t, test.warnings, diags.WarningsCount())
+			assert.Equal(t, test.summary, diags.Errors()[0].Summary())
+
+			attribute := path.Empty()
+			if d, ok := diags.Errors()[0].(diag.DiagnosticWithPath); ok {
+				attribute = d.Path()
+			}
+
+			assert.True(t, test.path.Equal(attribute))
+		})
+	}
+}
+
+func TestValidationPath(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		expected   path.Path
+		property   string
+		exceptions []fieldException
+	}{
+		"resource tags": {
+			property: "Tags",
+			expected: path.Root("tags"),
+		},
+		"field tags": {
+			property:   "Tags",
+			exceptions: getFieldExceptions(),
+			expected:   path.Root("field_tags"),
+		},
+		"resource nested": {
+			property: "seedCriteria.seedTime",
+			expected: path.Root("seed_criteria"),
+		},
+		"field nested": {
+			property:   "seedCriteria.seedTime",
+			exceptions: getFieldExceptions(),
+			expected:   path.Root("seed_time"),
+		},
+		"empty": {
+			expected: path.Empty(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.True(t, test.expected.Equal(validationPath(test.property, test.exceptions)), validationPath(test.property, test.exceptions).String())
+		})
+	}
+}
+
+func TestToSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		name     string
+		expected string
+	}{
+		"pascal":  {name: "BaseUrl", expected: "base_url"},
+		"camel":   {name: "apiKey", expected: "api_key"},
+		"acronym": {name: "APIKey", expected: "api_key"},
+		"single":  {name: "Host", expected: "host"},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, toSnakeCase(test.name))
+		})
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status   int
+		expected bool
+	}{
+		"not found": {
+			status:   http.StatusNotFound,
+			expected: true,
+		},
+		"server error": {
+			status:   http.StatusInternalServerError,
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+			}))
+			defer server.Close()
+
+			config := whisparr.NewConfiguration()
+			config.Servers[0].URL = server.URL
+			client := &Client{APIClient: whisparr.NewAPIClient(config)}
+
+			_, httpResp, err := client.TagApi.GetTagById(context.Background(), 1).Execute()
+			assert.Equal(t, test.expected, IsNotFound(httpResp, err))
+
+			err = client.Request(context.Background(), http.MethodGet, "/api/v3/tag/1", nil, nil)
+			assert.Equal(t, test.expected, IsNotFound(nil, err))
+		})
+	}
+}
@@ -349,3 +349,43 @@ func WriteFields(ctx context.Context, fieldContainer interface{}, fields []*whis
 		}
 	}
 }
+
+// FieldAttributes returns the terraform attribute names of all the fields, mapped on the field container
+// struct tags the same way ReadFields and WriteFields select the container fields.
+// Fields without a matching container field are returned in missing.
+func FieldAttributes(fieldContainer interface{}, fieldLists Fields) ([]string, []string) {
+	lists := []string{
+		"Bools", "BoolsExceptions",
+		"Ints", "IntsExceptions",
+		"Strings", "StringsExceptions",
+		"Floats", "FloatsExceptions",
+		"IntSlices", "IntSlicesExceptions",
+		"StringSlices", "StringSlicesExceptions",
+	}
+
+	container := reflect.Indirect(reflect.ValueOf(fieldContainer)).Type()
+	seen := make(map[string]bool)
+	attributes := []string{}
+	missing := []string{}
+
+	for _, list := range lists {
+		for _, name := range fieldLists.getList(list) {
+			fieldName := selectTFName(name)
+
+			field, found := container.FieldByNameFunc(func(n string) bool { return strings.EqualFold(n, fieldName) })
+			if !found || field.Tag.Get("tfsdk") == "" {
+				missing = append(missing, name)
+
+				continue
+			}
+
+			if attribute := field.Tag.Get("tfsdk"); !seen[attribute] {
+				seen[attribute] = true
+
+				attributes = append(attributes, attribute)
+			}
+		}
+	}
+
+	return attributes, missing
+}
@@ -629,3 +629,21 @@ func TestWriteFields(t *testing.T) {
 		})
 	}
 }
+
+func TestFieldAttributes(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		APIKey   types.String `tfsdk:"api_key"`
+		SeedTime types.Int64  `tfsdk:"seed_time"`
+		UseSSL   types.Bool   `tfsdk:"use_ssl"`
+	}
+
+	attributes, missing := FieldAttributes(&model{}, Fields{
+		Bools:          []string{"useSsl"},
+		Strings:        []string{"apiKey", "host"},
+		IntsExceptions: []string{"seedCriteria.seedTime"},
+	})
+	assert.ElementsMatch(t, []string{"api_key", "seed_time", "use_ssl"}, attributes)
+	assert.Equal(t, []string{"host"}, missing)
+}
@@ -0,0 +1,83 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ resource.ConfigValidator = ImplementationValidator{}
+
+// ImplementationValidator checks the fields of a generic resource against the specific resource
+// of the selected implementation: fields required there must be set, fields missing there must not.
+type ImplementationValidator struct {
+	// Resources maps each implementation to its specific resource.
+	Resources map[string]func() resource.Resource
+	// Attribute selects the implementation, e.g. implementation or config_contract.
+	Attribute string
+	// Model is the data model of the generic resource, mapping the fields to its attributes.
+	Model interface{}
+	// Fields are the field lists of the generic resource.
+	Fields Fields
+}
+
+func (v ImplementationValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("fields must match the ones supported by the selected %s", v.Attribute)
+}
+
+func (v ImplementationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ImplementationValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if !req.Config.Raw.IsKnown() || req.Config.Raw.IsNull() {
+		return
+	}
+
+	config := map[string]tftypes.Value{}
+	if err := req.Config.Raw.As(&config); err != nil {
+		return
+	}
+
+	var implementation string
+	if value, ok := config[v.Attribute]; !ok || !value.IsKnown() || value.IsNull() || value.As(&implementation) != nil {
+		return
+	}
+
+	newResource, ok := v.Resources[implementation]
+	if !ok {
+		return
+	}
+
+	specific := &resource.SchemaResponse{}
+	newResource().Schema(ctx, resource.SchemaRequest{}, specific)
+
+	attributes, _ := FieldAttributes(v.Model, v.Fields)
+
+	for _, name := range attributes {
+		value, found := config[name]
+		if !found || !value.IsKnown() {
+			continue
+		}
+
+		attribute, supported := specific.Schema.Attributes[name]
+
+		switch {
+		case !supported && !value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("Attribute %q is not supported by %s %q.", name, v.Attribute, implementation),
+			)
+		case supported && attribute.IsRequired() && value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("Attribute %q is required by %s %q.", name, v.Attribute, implementation),
+			)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// testSpecificResource is the specific resource of the "Test" implementation.
+type testSpecificResource struct {
+	testResource
+}
+
+func (r *testSpecificResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"api_key": schema.StringAttribute{
+				Required: true,
+			},
+			"use_ssl": schema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+// testGenericModel is the data model of the generic resource.
+type testGenericModel struct {
+	APIKey types.String `tfsdk:"api_key"`
+	Host   types.String `tfsdk:"host"`
+	UseSSL types.Bool   `tfsdk:"use_ssl"`
+}
+
+func TestImplementationValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		values         map[string]tftypes.Value
+		implementation string
+		errors         int
+	}{
+		"valid": {
+			implementation: "Test",
+			values: map[string]tftypes.Value{
+				"api_key": tftypes.NewValue(tftypes.String, "key"),
+				"use_ssl": tftypes.NewValue(tftypes.Bool, true),
+			},
+			errors: 0,
+		},
+		"missing required": {
+			implementation: "Test",
+			values:         map[string]tftypes.Value{},
+			errors:         1,
+		},
+		"unknown required": {
+			implementation: "Test",
+			values: map[string]tftypes.Value{
+				"api_key": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+			errors: 0,
+		},
+		"not supported": {
+			implementation: "Test",
+			values: map[string]tftypes.Value{
+				"api_key": tftypes.NewValue(tftypes.String, "key"),
+				"host":    tftypes.NewValue(tftypes.String, "localhost"),
+			},
+			errors: 1,
+		},
+		"other implementation": {
+			implementation: "Other",
+			values: map[string]tftypes.Value{
+				"host": tftypes.NewValue(tftypes.String, "localhost"),
+			},
+			errors: 0,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			generic := schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"implementation": schema.StringAttribute{Required: true},
+					"name":           schema.StringAttribute{Required: true},
+					"api_key":        schema.StringAttribute{Optional: true},
+					"host":           schema.StringAttribute{Optional: true},
+					"use_ssl":        schema.BoolAttribute{Optional: true},
+				},
+			}
+			typ := generic.Type().TerraformType(ctx)
+
+			values := map[string]tftypes.Value{
+				"implementation": tftypes.NewValue(tftypes.String, test.implementation),
+				"name":           tftypes.NewValue(tftypes.String, "test"),
+				"api_key":        tftypes.NewValue(tftypes.String, nil),
+				"host":           tftypes.NewValue(tftypes.String, nil),
+				"use_ssl":        tftypes.NewValue(tftypes.Bool, nil),
+			}
+			for k, v := range test.values {
+				values[k] = v
+			}
+
+			validator := ImplementationValidator{
+				Resources: map[string]func() resource.Resource{
+					"Test": func() resource.Resource { return &testSpecificResource{} },
+				},
+				Attribute: "implementation",
+				Model:     &testGenericModel{},
+				Fields: Fields{
+					Bools:   []string{"useSsl"},
+					Strings: []string{"apiKey", "host"},
+				},
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			validator.ValidateResource(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Raw: tftypes.NewValue(typ, values), Schema: generic},
+			}, resp)
+			assert.Equal(t, test.errors, resp.Diagnostics.ErrorsCount())
+		})
+	}
+}
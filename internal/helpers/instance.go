@@ -0,0 +1,591 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	// InstanceAttribute is the attribute selecting the provider instance.
+	InstanceAttribute = "instance"
+	// instanceSeparator splits the import identifier from the instance name.
+	instanceSeparator = "@"
+)
+
+// Ensure wrapper types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                  = &instanceResource{}
+	_ resource.ResourceWithConfigure     = &instanceResource{}
+	_ resource.ResourceWithImportState   = &instanceResource{}
+	_ resource.ResourceWithUpgradeState  = &instanceResource{}
+	_ datasource.DataSource              = &instanceDataSource{}
+	_ datasource.DataSourceWithConfigure = &instanceDataSource{}
+)
+
+// InstanceResources adds the instance attribute to the given resources.
+// Each operation configures the wrapped resource with the client of the selected instance,
+// so that resources keep working on a single *Client.
+func InstanceResources(resources []func() resource.Resource) []func() resource.Resource {
+	wrapped := make([]func() resource.Resource, len(resources))
+
+	for i, newResource := range resources {
+		newResource := newResource
+		wrapped[i] = func() resource.Resource {
+			return &instanceResource{Resource: newResource()}
+		}
+	}
+
+	return wrapped
+}
+
+// InstanceDataSources adds the instance attribute to the given data sources.
+func InstanceDataSources(dataSources []func() datasource.DataSource) []func() datasource.DataSource {
+	wrapped := make([]func() datasource.DataSource, len(dataSources))
+
+	for i, newDataSource := range dataSources {
+		newDataSource := newDataSource
+		wrapped[i] = func() datasource.DataSource {
+			return &instanceDataSource{DataSource: newDataSource()}
+		}
+	}
+
+	return wrapped
+}
+
+// instanceResource wraps a resource adding the instance attribute.
+type instanceResource struct {
+	resource.Resource
+	registry *Registry
+}
+
+func (r *instanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	r.Resource.Schema(ctx, req, resp)
+	resp.Schema = instanceSchema(resp.Schema)
+}
+
+// instanceSchema adds the instance attribute to a resource schema.
+func instanceSchema(wrapped schema.Schema) schema.Schema {
+	attributes := make(map[string]schema.Attribute, len(wrapped.Attributes)+1)
+	for name, attribute := range wrapped.Attributes {
+		attributes[name] = attribute
+	}
+
+	attributes[InstanceAttribute] = schema.StringAttribute{
+		MarkdownDescription: "Name of the provider `instances` entry to manage the resource on. Defaults to the provider `url`. To import on an instance use `<ID>@<instance>` as identifier.",
+		Optional:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	wrapped.Attributes = attributes
+
+	return wrapped
+}
+
+func (r *instanceResource) MoveState(ctx context.Context) []resource.StateMover {
+	mover, ok := r.Resource.(resource.ResourceWithMoveState)
+	if !ok {
+		return nil
+	}
+
+	movers := mover.MoveState(ctx)
+	wrapped := make([]resource.StateMover, 0, len(movers))
+
+	for _, m := range movers {
+		// Source states without schema cannot be split from their instance.
+		if m.SourceSchema == nil {
+			continue
+		}
+
+		source := *m.SourceSchema
+		sourceSchema := instanceSchema(source)
+		wrappedMover := m.StateMover
+
+		wrapped = append(wrapped, resource.StateMover{
+			SourceSchema: &sourceSchema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceState == nil {
+					return
+				}
+
+				target := r.wrappedType(ctx, &resp.Diagnostics)
+				sourceState, instance := splitInstance(req.SourceState.Raw, source.Type().TerraformType(ctx), &resp.Diagnostics)
+				targetState, _ := splitInstance(resp.TargetState.Raw, target.Type, &resp.Diagnostics)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				wrappedResp := &resource.MoveStateResponse{
+					TargetState:   tfsdk.State{Raw: targetState, Schema: target.Schema},
+					TargetPrivate: resp.TargetPrivate,
+				}
+				wrappedMover(ctx, resource.MoveStateRequest{
+					SourcePrivate:         req.SourcePrivate,
+					SourceProviderAddress: req.SourceProviderAddress,
+					SourceRawState:        req.SourceRawState,
+					SourceSchemaVersion:   req.SourceSchemaVersion,
+					SourceState:           &tfsdk.State{Raw: sourceState, Schema: source},
+					SourceTypeName:        req.SourceTypeName,
+				}, wrappedResp)
+
+				resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+				resp.TargetState.Raw = joinInstance(wrappedResp.TargetState.Raw, instance, resp.TargetState.Schema.Type().TerraformType(ctx), &resp.Diagnostics)
+				resp.TargetPrivate = wrappedResp.TargetPrivate
+			},
+		})
+	}
+
+	return wrapped
+}
+
+func (r *instanceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	upgrader, ok := r.Resource.(resource.ResourceWithUpgradeState)
+	if !ok {
+		return nil
+	}
+
+	upgraders := upgrader.UpgradeState(ctx)
+	wrapped := make(map[int64]resource.StateUpgrader, len(upgraders))
+
+	for version, u := range upgraders {
+		var prior *schema.Schema
+
+		if u.PriorSchema != nil {
+			priorSchema := instanceSchema(*u.PriorSchema)
+			prior = &priorSchema
+		}
+
+		wrapped[version] = resource.StateUpgrader{
+			PriorSchema:   prior,
+			StateUpgrader: r.upgradeState(u),
+		}
+	}
+
+	return wrapped
+}
+
+// upgradeState runs the wrapped upgrader without the instance attribute, keeping its prior value.
+func (r *instanceResource) upgradeState(u resource.StateUpgrader) func(context.Context, resource.UpgradeStateRequest, *resource.UpgradeStateResponse) {
+	return func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+		target := r.wrappedType(ctx, &resp.Diagnostics)
+		wrappedReq := resource.UpgradeStateRequest{RawState: req.RawState}
+		instance := tftypes.NewValue(tftypes.String, nil)
+
+		if u.PriorSchema != nil && req.State != nil {
+			var state tftypes.Value
+
+			state, instance = splitInstance(req.State.Raw, u.PriorSchema.Type().TerraformType(ctx), &resp.Diagnostics)
+			wrappedReq.State = &tfsdk.State{Raw: state, Schema: *u.PriorSchema}
+		} else if req.RawState != nil {
+			instance = rawInstance(req.RawState, &resp.Diagnostics)
+		}
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		wrappedResp := &resource.UpgradeStateResponse{
+			State: tfsdk.State{Raw: tftypes.NewValue(target.Type, nil), Schema: target.Schema},
+		}
+		u.StateUpgrader(ctx, wrappedReq, wrappedResp)
+		resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state := wrappedResp.State.Raw
+
+		if wrappedResp.DynamicValue != nil {
+			var err error
+
+			state, err = wrappedResp.DynamicValue.Unmarshal(target.Type)
+			if err != nil {
+				resp.Diagnostics.AddError(ResourceError, fmt.Sprintf("Unable to upgrade state, got error: %s", err))
+
+				return
+			}
+		}
+
+		resp.State.Raw = joinInstance(state, instance, resp.State.Schema.Type().TerraformType(ctx), &resp.Diagnostics)
+	}
+}
+
+// rawInstance reads the instance attribute from a raw state of any schema version.
+func rawInstance(raw *tfprotov6.RawState, diags *diag.Diagnostics) tftypes.Value {
+	typ := tftypes.Object{AttributeTypes: map[string]tftypes.Type{InstanceAttribute: tftypes.String}}
+
+	state, err := raw.UnmarshalWithOpts(typ, tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+			IgnoreUndefinedAttributes: true,
+		},
+	})
+	if err != nil {
+		diags.AddError(ResourceError, fmt.Sprintf("Unable to read %s, got error: %s", InstanceAttribute, err))
+
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	attributes := map[string]tftypes.Value{}
+	if err := state.As(&attributes); err != nil || attributes[InstanceAttribute].Type() == nil {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	return attributes[InstanceAttribute]
+}
+
+func (r *instanceResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	// Validators address attributes by path, so they work on the config including the instance.
+	if v, ok := r.Resource.(resource.ResourceWithConfigValidators); ok {
+		return v.ConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (r *instanceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if registry := providerData[Registry](req.ProviderData, UnexpectedResourceConfigureType, &resp.Diagnostics); registry != nil {
+		r.registry = registry
+	}
+}
+
+func (r *instanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	wrapped := r.wrappedType(ctx, &resp.Diagnostics)
+	config, _ := splitInstance(req.Config.Raw, wrapped.Type, &resp.Diagnostics)
+	plan, instance := splitInstance(req.Plan.Raw, wrapped.Type, &resp.Diagnostics)
+	state, _ := splitInstance(resp.State.Raw, wrapped.Type, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() || !r.configure(ctx, instance, &resp.Diagnostics) {
+		return
+	}
+
+	wrappedResp := &resource.CreateResponse{
+		State:   tfsdk.State{Raw: state, Schema: wrapped.Schema},
+		Private: resp.Private,
+	}
+	r.Resource.Create(ctx, resource.CreateRequest{
+		Config:       tfsdk.Config{Raw: config, Schema: wrapped.Schema},
+		Plan:         tfsdk.Plan{Raw: plan, Schema: wrapped.Schema},
+		ProviderMeta: req.ProviderMeta,
+	}, wrappedResp)
+
+	resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	resp.State.Raw = joinInstance(wrappedResp.State.Raw, instance, resp.State.Schema.Type().TerraformType(ctx), &resp.Diagnostics)
+	resp.Private = wrappedResp.Private
+}
+
+func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	wrapped := r.wrappedType(ctx, &resp.Diagnostics)
+	state, instance := splitInstance(req.State.Raw, wrapped.Type, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() || !r.configure(ctx, instance, &resp.Diagnostics) {
+		return
+	}
+
+	wrappedResp := &resource.ReadResponse{
+		State:   tfsdk.State{Raw: state, Schema: wrapped.Schema},
+		Private: resp.Private,
+	}
+	r.Resource.Read(ctx, resource.ReadRequest{
+		State:        tfsdk.State{Raw: state, Schema: wrapped.Schema},
+		Private:      req.Private,
+		ProviderMeta: req.ProviderMeta,
+	}, wrappedResp)
+
+	resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	resp.State.Raw = joinInstance(wrappedResp.State.Raw, instance, resp.State.Schema.Type().TerraformType(ctx), &resp.Diagnostics)
+	resp.Private = wrappedResp.Private
+}
+
+func (r *instanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	wrapped := r.wrappedType(ctx, &resp.Diagnostics)
+	config, _ := splitInstance(req.Config.Raw, wrapped.Type, &resp.Diagnostics)
+	plan, instance := splitInstance(req.Plan.Raw, wrapped.Type, &resp.Diagnostics)
+	state, _ := splitInstance(req.State.Raw, wrapped.Type, &resp.Diagnostics)
+	newState, _ := splitInstance(resp.State.Raw, wrapped.Type, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() || !r.configure(ctx, instance, &resp.Diagnostics) {
+		return
+	}
+
+	wrappedResp := &resource.UpdateResponse{
+		State:   tfsdk.State{Raw: newState, Schema: wrapped.Schema},
+		Private: resp.Private,
+	}
+	r.Resource.Update(ctx, resource.UpdateRequest{
+		Config:       tfsdk.Config{Raw: config, Schema: wrapped.Schema},
+		Plan:         tfsdk.Plan{Raw: plan, Schema: wrapped.Schema},
+		State:        tfsdk.State{Raw: state, Schema: wrapped.Schema},
+		Private:      req.Private,
+		ProviderMeta: req.ProviderMeta,
+	}, wrappedResp)
+
+	resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	resp.State.Raw = joinInstance(wrappedResp.State.Raw, instance, resp.State.Schema.Type().TerraformType(ctx), &resp.Diagnostics)
+	resp.Private = wrappedResp.Private
+}
+
+func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	wrapped := r.wrappedType(ctx, &resp.Diagnostics)
+	state, instance := splitInstance(req.State.Raw, wrapped.Type, &resp.Diagnostics)
+	newState, _ := splitInstance(resp.State.Raw, wrapped.Type, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() || !r.configure(ctx, instance, &resp.Diagnostics) {
+		return
+	}
+
+	wrappedResp := &resource.DeleteResponse{
+		State:   tfsdk.State{Raw: newState, Schema: wrapped.Schema},
+		Private: resp.Private,
+	}
+	r.Resource.Delete(ctx, resource.DeleteRequest{
+		State:        tfsdk.State{Raw: state, Schema: wrapped.Schema},
+		Private:      req.Private,
+		ProviderMeta: req.ProviderMeta,
+	}, wrappedResp)
+
+	resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	resp.State.Raw = joinInstance(wrappedResp.State.Raw, instance, resp.State.Schema.Type().TerraformType(ctx), &resp.Diagnostics)
+	resp.Private = wrappedResp.Private
+}
+
+func (r *instanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importer, ok := r.Resource.(resource.ResourceWithImportState)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Resource Import Not Implemented",
+			"This resource does not support import. Please contact the provider developer for additional information.",
+		)
+
+		return
+	}
+
+	id, name := req.ID, ""
+	if i := strings.LastIndex(req.ID, instanceSeparator); i >= 0 {
+		id, name = req.ID[:i], req.ID[i+1:]
+	}
+
+	instance := tftypes.NewValue(tftypes.String, nil)
+	if name != "" {
+		instance = tftypes.NewValue(tftypes.String, name)
+	}
+
+	wrapped := r.wrappedType(ctx, &resp.Diagnostics)
+	state, _ := splitInstance(resp.State.Raw, wrapped.Type, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() || !r.configure(ctx, instance, &resp.Diagnostics) {
+		return
+	}
+
+	wrappedResp := &resource.ImportStateResponse{
+		State:   tfsdk.State{Raw: state, Schema: wrapped.Schema},
+		Private: resp.Private,
+	}
+	importer.ImportState(ctx, resource.ImportStateRequest{ID: id}, wrappedResp)
+
+	resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	resp.State.Raw = joinInstance(wrappedResp.State.Raw, instance, resp.State.Schema.Type().TerraformType(ctx), &resp.Diagnostics)
+	resp.Private = wrappedResp.Private
+}
+
+// wrappedSchema holds the schema of the wrapped object with its terraform type.
+type wrappedSchema struct {
+	Type   tftypes.Type
+	Schema schema.Schema
+}
+
+func (r *instanceResource) wrappedType(ctx context.Context, diags *diag.Diagnostics) wrappedSchema {
+	resp := &resource.SchemaResponse{}
+	r.Resource.Schema(ctx, resource.SchemaRequest{}, resp)
+	diags.Append(resp.Diagnostics...)
+
+	return wrappedSchema{Schema: resp.Schema, Type: resp.Schema.Type().TerraformType(ctx)}
+}
+
+// configure sets the client of the selected instance on the wrapped resource.
+func (r *instanceResource) configure(ctx context.Context, instance tftypes.Value, diags *diag.Diagnostics) bool {
+	configurable, ok := r.Resource.(resource.ResourceWithConfigure)
+	if !ok || r.registry == nil {
+		return true
+	}
+
+	client := selectClient(r.registry, instance, diags)
+	if client == nil {
+		return false
+	}
+
+	resp := &resource.ConfigureResponse{}
+	configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, resp)
+	diags.Append(resp.Diagnostics...)
+
+	return !diags.HasError()
+}
+
+// instanceDataSource wraps a data source adding the instance attribute.
+type instanceDataSource struct {
+	datasource.DataSource
+	registry *Registry
+}
+
+func (d *instanceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	d.DataSource.Schema(ctx, req, resp)
+
+	attributes := make(map[string]dataSourceSchema.Attribute, len(resp.Schema.Attributes)+1)
+	for name, attribute := range resp.Schema.Attributes {
+		attributes[name] = attribute
+	}
+
+	attributes[InstanceAttribute] = dataSourceSchema.StringAttribute{
+		MarkdownDescription: "Name of the provider `instances` entry to read from. Defaults to the provider `url`.",
+		Optional:            true,
+	}
+	resp.Schema.Attributes = attributes
+}
+
+func (d *instanceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if registry := providerData[Registry](req.ProviderData, UnexpectedDataSourceConfigureType, &resp.Diagnostics); registry != nil {
+		d.registry = registry
+	}
+}
+
+func (d *instanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	schemaResp := &datasource.SchemaResponse{}
+	d.DataSource.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	resp.Diagnostics.Append(schemaResp.Diagnostics...)
+
+	wrapped := schemaResp.Schema.Type().TerraformType(ctx)
+	config, instance := splitInstance(req.Config.Raw, wrapped, &resp.Diagnostics)
+	state, _ := splitInstance(resp.State.Raw, wrapped, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() || !d.configure(ctx, instance, &resp.Diagnostics) {
+		return
+	}
+
+	wrappedResp := &datasource.ReadResponse{
+		State: tfsdk.State{Raw: state, Schema: schemaResp.Schema},
+	}
+	d.DataSource.Read(ctx, datasource.ReadRequest{
+		Config:       tfsdk.Config{Raw: config, Schema: schemaResp.Schema},
+		ProviderMeta: req.ProviderMeta,
+	}, wrappedResp)
+
+	resp.Diagnostics.Append(wrappedResp.Diagnostics...)
+	resp.State.Raw = joinInstance(wrappedResp.State.Raw, instance, resp.State.Schema.Type().TerraformType(ctx), &resp.Diagnostics)
+}
+
+// configure sets the client of the selected instance on the wrapped data source.
+func (d *instanceDataSource) configure(ctx context.Context, instance tftypes.Value, diags *diag.Diagnostics) bool {
+	configurable, ok := d.DataSource.(datasource.DataSourceWithConfigure)
+	if !ok || d.registry == nil {
+		return true
+	}
+
+	client := selectClient(d.registry, instance, diags)
+	if client == nil {
+		return false
+	}
+
+	resp := &datasource.ConfigureResponse{}
+	configurable.Configure(ctx, datasource.ConfigureRequest{ProviderData: client}, resp)
+	diags.Append(resp.Diagnostics...)
+
+	return !diags.HasError()
+}
+
+// selectClient returns the client for the instance value.
+func selectClient(registry *Registry, instance tftypes.Value, diags *diag.Diagnostics) *Client {
+	var name string
+
+	if instance.IsKnown() && !instance.IsNull() {
+		if err := instance.As(&name); err != nil {
+			diags.AddError(ClientError, fmt.Sprintf("Unable to read %s, got error: %s", InstanceAttribute, err))
+
+			return nil
+		}
+	}
+
+	client, err := registry.Get(name)
+	if err != nil {
+		diags.AddAttributeError(path.Root(InstanceAttribute), ClientError, fmt.Sprintf("Unable to select %s, got error: %s", InstanceAttribute, err))
+
+		return nil
+	}
+
+	return client
+}
+
+// splitInstance removes the instance attribute from a raw object,
+// returning the object typed as the wrapped schema and the instance value.
+func splitInstance(raw tftypes.Value, wrapped tftypes.Type, diags *diag.Diagnostics) (tftypes.Value, tftypes.Value) {
+	if raw.IsNull() {
+		return tftypes.NewValue(wrapped, nil), tftypes.NewValue(tftypes.String, nil)
+	}
+
+	if !raw.IsKnown() {
+		return tftypes.NewValue(wrapped, tftypes.UnknownValue), tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	}
+
+	attributes := map[string]tftypes.Value{}
+	if err := raw.As(&attributes); err != nil {
+		diags.AddError(ClientError, fmt.Sprintf("Unable to read %s, got error: %s", InstanceAttribute, err))
+
+		return tftypes.NewValue(wrapped, nil), tftypes.NewValue(tftypes.String, nil)
+	}
+
+	// As shares the underlying map, copy it before removing the instance.
+	instance := tftypes.NewValue(tftypes.String, nil)
+	values := make(map[string]tftypes.Value, len(attributes))
+
+	for name, value := range attributes {
+		if name == InstanceAttribute {
+			instance = value
+
+			continue
+		}
+
+		values[name] = value
+	}
+
+	return tftypes.NewValue(wrapped, values), instance
+}
+
+// joinInstance adds the instance attribute back to a raw wrapped object.
+func joinInstance(raw, instance tftypes.Value, typ tftypes.Type, diags *diag.Diagnostics) tftypes.Value {
+	if raw.IsNull() {
+		return tftypes.NewValue(typ, nil)
+	}
+
+	if !raw.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+
+	attributes := map[string]tftypes.Value{}
+	if err := raw.As(&attributes); err != nil {
+		diags.AddError(ClientError, fmt.Sprintf("Unable to write %s, got error: %s", InstanceAttribute, err))
+
+		return tftypes.NewValue(typ, nil)
+	}
+
+	values := make(map[string]tftypes.Value, len(attributes)+1)
+	for name, value := range attributes {
+		values[name] = value
+	}
+
+	values[InstanceAttribute] = instance
+
+	return tftypes.NewValue(typ, values)
+}
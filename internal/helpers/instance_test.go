@@ -0,0 +1,168 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// testResource stores the configured client and copies the plan into the state.
+type testResource struct {
+	client *Client
+}
+
+type testModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (r *testResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "whisparr_test"
+}
+
+func (r *testResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *testResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *testResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model testModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	model.ID = types.StringValue("1")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *testResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {}
+
+func (r *testResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r *testResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
+
+func TestRegistryGet(t *testing.T) {
+	t.Parallel()
+
+	registry := &Registry{
+		Default:   &Client{},
+		Instances: map[string]*Client{"second": {}},
+	}
+
+	tests := map[string]struct {
+		expected *Client
+		err      error
+		instance string
+	}{
+		"default": {
+			instance: "",
+			expected: registry.Default,
+		},
+		"instance": {
+			instance: "second",
+			expected: registry.Instances["second"],
+		},
+		"unknown": {
+			instance: "third",
+			err:      ErrUnknownInstance,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := registry.Get(test.instance)
+			assert.ErrorIs(t, err, test.err)
+			assert.Same(t, test.expected, client)
+		})
+	}
+}
+
+func TestInstanceResource(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		instance tftypes.Value
+		name     string
+	}{
+		"default": {
+			instance: tftypes.NewValue(tftypes.String, nil),
+			name:     "",
+		},
+		"instance": {
+			instance: tftypes.NewValue(tftypes.String, "second"),
+			name:     "second",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			registry := &Registry{
+				Default:   &Client{},
+				Instances: map[string]*Client{"second": {}},
+			}
+			wrapped := &testResource{}
+			r := InstanceResources([]func() resource.Resource{func() resource.Resource { return wrapped }})[0]()
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			typ := schemaResp.Schema.Type().TerraformType(ctx)
+
+			configureResp := &resource.ConfigureResponse{}
+			r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: registry}, configureResp)
+			assert.False(t, configureResp.Diagnostics.HasError())
+
+			plan := tftypes.NewValue(typ, map[string]tftypes.Value{
+				"id":              tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"name":            tftypes.NewValue(tftypes.String, "test"),
+				InstanceAttribute: test.instance,
+			})
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{Raw: tftypes.NewValue(typ, nil), Schema: schemaResp.Schema},
+			}
+			r.Create(ctx, resource.CreateRequest{
+				Config: tfsdk.Config{Raw: plan, Schema: schemaResp.Schema},
+				Plan:   tfsdk.Plan{Raw: plan, Schema: schemaResp.Schema},
+			}, resp)
+			assert.False(t, resp.Diagnostics.HasError())
+
+			expected, _ := registry.Get(test.name)
+			assert.Same(t, expected, wrapped.client)
+
+			var id, instance types.String
+
+			resp.State.GetAttribute(ctx, path.Root("id"), &id)
+			resp.State.GetAttribute(ctx, path.Root(InstanceAttribute), &instance)
+			assert.Equal(t, "1", id.ValueString())
+			assert.Equal(t, test.name, instance.ValueString())
+		})
+	}
+}
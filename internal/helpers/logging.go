@@ -0,0 +1,176 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const redacted = "***"
+
+// redactedHeaders are the headers never written in logs.
+var redactedHeaders = []string{"X-Api-Key", "Authorization", "Cookie", "Set-Cookie"}
+
+// LoggingTransport logs requests and responses through tflog, redacting the sensitive values.
+// Method, URL, status and latency are logged at debug level, headers and bodies at trace level.
+type LoggingTransport struct {
+	Transport http.RoundTripper
+	sensitive map[string]bool
+}
+
+// NewLoggingTransport wraps the given transport with logging.
+// Sensitive names are matched on both API and terraform names (e.g. apiKey and api_key).
+func NewLoggingTransport(transport http.RoundTripper, sensitive []string) *LoggingTransport {
+	t := &LoggingTransport{
+		Transport: transport,
+		sensitive: make(map[string]bool, len(sensitive)),
+	}
+
+	for _, name := range sensitive {
+		t.sensitive[normalizeName(name)] = true
+	}
+
+	return t
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	requestBody, req, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	resp, err := t.Transport.RoundTrip(req)
+	fields := map[string]interface{}{
+		"method":     req.Method,
+		"url":        req.URL.String(),
+		"latency_ms": time.Since(start).Milliseconds(),
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "whisparr request failed", fields)
+
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+	tflog.Debug(ctx, "whisparr request", fields)
+
+	responseBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	fields["request_headers"] = redactHeaders(req.Header)
+	fields["request_body"] = t.redactBody(requestBody)
+	fields["response_headers"] = redactHeaders(resp.Header)
+	fields["response_body"] = t.redactBody(responseBody)
+	tflog.Trace(ctx, "whisparr request details", fields)
+
+	return resp, nil
+}
+
+// readRequestBody returns a copy of the request body, leaving the request readable.
+func readRequestBody(req *http.Request) ([]byte, *http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, req, nil
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		defer body.Close()
+
+		payload, err := io.ReadAll(body)
+
+		return payload, req, err
+	}
+
+	payload, err := io.ReadAll(req.Body)
+	req.Body.Close()
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clone := req.Clone(req.Context())
+	clone.Body = io.NopCloser(bytes.NewReader(payload))
+
+	return payload, clone, nil
+}
+
+func redactHeaders(header http.Header) http.Header {
+	clone := header.Clone()
+
+	for _, name := range redactedHeaders {
+		if clone.Get(name) != "" {
+			clone.Set(name, redacted)
+		}
+	}
+
+	return clone
+}
+
+// redactBody hides the sensitive values of a JSON body, either as keys or as name/value fields.
+func (t *LoggingTransport) redactBody(body []byte) string {
+	var payload interface{}
+	if len(body) == 0 || json.Unmarshal(body, &payload) != nil {
+		return string(body)
+	}
+
+	redactedBody, err := json.Marshal(t.redactValue(payload))
+	if err != nil {
+		return redacted
+	}
+
+	return string(redactedBody)
+}
+
+func (t *LoggingTransport) redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		// Provider fields are sent as {"name": "password", "value": "secret"}.
+		if name, ok := v["name"].(string); ok && t.sensitive[normalizeName(name)] {
+			if _, found := v["value"]; found {
+				v["value"] = redacted
+			}
+		}
+
+		for key, item := range v {
+			if t.sensitive[normalizeName(key)] {
+				v[key] = redacted
+
+				continue
+			}
+
+			v[key] = t.redactValue(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = t.redactValue(item)
+		}
+	}
+
+	return value
+}
+
+// normalizeName makes API and terraform names comparable.
+func normalizeName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
@@ -0,0 +1,81 @@
+package helpers
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactBody(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body     string
+		expected string
+	}{
+		"key": {
+			body:     `{"apiKey":"secret","name":"test"}`,
+			expected: `{"apiKey":"***","name":"test"}`,
+		},
+		"fields": {
+			body:     `{"fields":[{"name":"password","value":"secret"},{"name":"host","value":"localhost"}]}`,
+			expected: `{"fields":[{"name":"password","value":"***"},{"name":"host","value":"localhost"}]}`,
+		},
+		"list": {
+			body:     `[{"id":1,"passkey":"secret"}]`,
+			expected: `[{"id":1,"passkey":"***"}]`,
+		},
+		"not json": {
+			body:     `not found`,
+			expected: `not found`,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			transport := NewLoggingTransport(http.DefaultTransport, []string{"api_key", "password", "passkey"})
+			assert.Equal(t, test.expected, transport.redactBody([]byte(test.body)))
+		})
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	t.Parallel()
+
+	header := http.Header{}
+	header.Set("X-Api-Key", "secret")
+	header.Set("Accept", "application/json")
+
+	redactedHeader := redactHeaders(header)
+	assert.Equal(t, redacted, redactedHeader.Get("X-Api-Key"))
+	assert.Equal(t, "application/json", redactedHeader.Get("Accept"))
+	assert.Equal(t, "secret", header.Get("X-Api-Key"))
+}
+
+func TestLoggingTransport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewLoggingTransport(http.DefaultTransport, []string{"password"})}
+
+	resp, err := client.Post(server.URL, "application/json", io.NopCloser(strings.NewReader(`{"password":"secret"}`)))
+	assert.Nil(t, err)
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"password":"secret"}`, string(body))
+}
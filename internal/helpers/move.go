@@ -0,0 +1,70 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// MoveFromGeneric returns a state mover from a generic resource to a specific one.
+// It applies only to the generic states with the given value of the attribute selecting the implementation,
+// copying the attributes shared by the two data models.
+func MoveFromGeneric(ctx context.Context, generic resource.Resource, attribute, value string) resource.StateMover {
+	metadata := &resource.MetadataResponse{}
+	generic.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "whisparr"}, metadata)
+
+	source := &resource.SchemaResponse{}
+	generic.Schema(ctx, resource.SchemaRequest{}, source)
+
+	return resource.StateMover{
+		SourceSchema: &source.Schema,
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if req.SourceTypeName != metadata.TypeName || req.SourceState == nil {
+				return
+			}
+
+			values := map[string]tftypes.Value{}
+			if err := req.SourceState.Raw.As(&values); err != nil {
+				resp.Diagnostics.AddError(ResourceError, fmt.Sprintf("Unable to move %s, got error: %s", metadata.TypeName, err))
+
+				return
+			}
+
+			var implementation string
+			if err := values[attribute].As(&implementation); err != nil || implementation != value {
+				resp.Diagnostics.AddError(
+					ResourceError,
+					fmt.Sprintf("Unable to move %s, %s %q does not match the target %q", metadata.TypeName, attribute, implementation, value),
+				)
+
+				return
+			}
+
+			target, ok := resp.TargetState.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			if !ok {
+				return
+			}
+
+			resp.TargetState.Raw = tftypes.NewValue(target, moveValues(values, target))
+		},
+	}
+}
+
+// moveValues keeps the values of the target attributes with the same name and type, the others are null.
+func moveValues(values map[string]tftypes.Value, target tftypes.Object) map[string]tftypes.Value {
+	moved := make(map[string]tftypes.Value, len(target.AttributeTypes))
+
+	for name, typ := range target.AttributeTypes {
+		if value, ok := values[name]; ok && value.Type().Equal(typ) {
+			moved[name] = value
+
+			continue
+		}
+
+		moved[name] = tftypes.NewValue(typ, nil)
+	}
+
+	return moved
+}
@@ -0,0 +1,109 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// testGenericResource is a generic resource selecting the implementation by attribute.
+type testGenericResource struct {
+	testResource
+}
+
+func (r *testGenericResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "whisparr_test_generic"
+}
+
+func (r *testGenericResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"implementation": schema.StringAttribute{
+				Required: true,
+			},
+			"host": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func TestMoveFromGeneric(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		typeName       string
+		implementation string
+		moved          bool
+		err            bool
+	}{
+		"moved": {
+			typeName:       "whisparr_test_generic",
+			implementation: "Test",
+			moved:          true,
+		},
+		"other type": {
+			typeName:       "whisparr_other",
+			implementation: "Test",
+		},
+		"other implementation": {
+			typeName:       "whisparr_test_generic",
+			implementation: "Other",
+			err:            true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			mover := MoveFromGeneric(ctx, &testGenericResource{}, "implementation", "Test")
+
+			targetSchema := &resource.SchemaResponse{}
+			(&testResource{}).Schema(ctx, resource.SchemaRequest{}, targetSchema)
+			targetType := targetSchema.Schema.Type().TerraformType(ctx)
+
+			source := tftypes.NewValue(mover.SourceSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"id":             tftypes.NewValue(tftypes.String, "1"),
+				"name":           tftypes.NewValue(tftypes.String, "test"),
+				"implementation": tftypes.NewValue(tftypes.String, test.implementation),
+				"host":           tftypes.NewValue(tftypes.String, "localhost"),
+			})
+
+			resp := &resource.MoveStateResponse{
+				TargetState: tfsdk.State{Raw: tftypes.NewValue(targetType, nil), Schema: targetSchema.Schema},
+			}
+			mover.StateMover(ctx, resource.MoveStateRequest{
+				SourceTypeName: test.typeName,
+				SourceState:    &tfsdk.State{Raw: source, Schema: *mover.SourceSchema},
+			}, resp)
+
+			assert.Equal(t, test.err, resp.Diagnostics.HasError())
+
+			if !test.moved {
+				assert.True(t, resp.TargetState.Raw.IsNull())
+
+				return
+			}
+
+			expected := tftypes.NewValue(targetType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "1"),
+				"name": tftypes.NewValue(tftypes.String, "test"),
+			})
+			assert.True(t, expected.Equal(resp.TargetState.Raw))
+		})
+	}
+}
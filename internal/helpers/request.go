@@ -0,0 +1,93 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+var ErrRequestFailed = errors.New("request failed")
+
+// RequestError is the error of a Request answered with a non successful status.
+type RequestError struct {
+	Status     string
+	Body       []byte
+	StatusCode int
+}
+
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("%s: %s\nDetails:\n%s", ErrRequestFailed, e.Status, string(e.Body))
+}
+
+// Unwrap makes the error match ErrRequestFailed.
+func (e *RequestError) Unwrap() error {
+	return ErrRequestFailed
+}
+
+// Request sends a raw API request with the client configuration, for endpoints not covered by the SDK.
+// The body, if any, is encoded as JSON and the response is decoded into result, if not nil.
+func (c *Client) Request(ctx context.Context, method, path string, body, result any) error {
+	config := c.GetConfig()
+
+	server, err := config.ServerURLWithContext(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	var reader io.Reader
+
+	if body != nil {
+		payload, marshalErr := json.Marshal(body)
+		if marshalErr != nil {
+			return marshalErr
+		}
+
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, server+path, reader)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	for k, v := range config.DefaultHeader {
+		req.Header.Set(k, v)
+	}
+
+	if config.UserAgent != "" {
+		req.Header.Set("User-Agent", config.UserAgent)
+	}
+
+	resp, err := config.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return &RequestError{
+			Status:     resp.Status,
+			Body:       respBody,
+			StatusCode: resp.StatusCode,
+		}
+	}
+
+	if result == nil || len(respBody) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(respBody, result)
+}
@@ -0,0 +1,75 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err      error
+		response string
+		status   int
+		expected int
+	}{
+		"decoded": {
+			status:   http.StatusOK,
+			response: `[{"id":2},{"id":3}]`,
+			expected: 2,
+		},
+		"empty": {
+			status:   http.StatusAccepted,
+			expected: 0,
+		},
+		"not found": {
+			status:   http.StatusNotFound,
+			response: `{"message":"not found"}`,
+			err:      ErrRequestFailed,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/api/v3/delayprofile/reorder/3", r.URL.Path)
+				assert.Equal(t, "afterId=2", r.URL.RawQuery)
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.response))
+			}))
+			defer server.Close()
+
+			config := whisparr.NewConfiguration()
+			config.Servers[0].URL = server.URL
+			client := &Client{APIClient: whisparr.NewAPIClient(config)}
+
+			var result []map[string]int
+
+			err := client.Request(context.Background(), http.MethodPut, "/api/v3/delayprofile/reorder/3?afterId=2", nil, &result)
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+
+				var requestErr *RequestError
+				if assert.ErrorAs(t, err, &requestErr) {
+					assert.Equal(t, test.status, requestErr.StatusCode)
+					assert.Equal(t, test.response, string(requestErr.Body))
+				}
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, result, test.expected)
+		})
+	}
+}
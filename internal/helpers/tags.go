@@ -0,0 +1,176 @@
+package helpers
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const tagKind = "tag"
+
+// TagLabelMap maps the tag IDs to their labels.
+// Tags are retrieved once and cached until InvalidateTagLabels is called, the returned map must not be modified.
+func (c *Client) TagLabelMap(ctx context.Context, diags *diag.Diagnostics) map[int64]string {
+	c.tagMutex.Lock()
+	defer c.tagMutex.Unlock()
+
+	if c.tagLabels != nil {
+		return c.tagLabels
+	}
+
+	response, _, err := c.TagApi.ListTag(ctx).Execute()
+	if err != nil {
+		diags.Append(ParseClientDiagnostics(List, tagKind, err)...)
+
+		return nil
+	}
+
+	c.tagLabels = make(map[int64]string, len(response))
+	for _, t := range response {
+		c.tagLabels[int64(t.GetId())] = t.GetLabel()
+	}
+
+	return c.tagLabels
+}
+
+// InvalidateTagLabels drops the cached tag labels, to be called after any tag change.
+func (c *Client) InvalidateTagLabels() {
+	c.tagMutex.Lock()
+	defer c.tagMutex.Unlock()
+
+	c.tagLabels = nil
+}
+
+// ResolveTagLabels sets the tag IDs matching the given labels.
+// Missing tags are created when the provider is configured to do so.
+// Nothing is done if labels are not set.
+func (c *Client) ResolveTagLabels(ctx context.Context, labels types.Set, tags *types.Set, diags *diag.Diagnostics) {
+	if labels.IsNull() || labels.IsUnknown() {
+		return
+	}
+
+	labelMap := c.TagLabelMap(ctx, diags)
+	if diags.HasError() {
+		return
+	}
+
+	var input []string
+
+	diags.Append(labels.ElementsAs(ctx, &input, false)...)
+
+	IDs := make([]int64, 0, len(input))
+
+	for _, label := range input {
+		ID, found := findTagID(label, labelMap)
+		if !found {
+			// Tags could have been created since the labels were cached.
+			c.InvalidateTagLabels()
+			labelMap = c.TagLabelMap(ctx, diags)
+			ID, found = findTagID(label, labelMap)
+		}
+
+		if !found && !diags.HasError() {
+			ID = c.createTag(ctx, label, diags)
+		}
+
+		if diags.HasError() {
+			return
+		}
+
+		IDs = append(IDs, ID)
+	}
+
+	var tempDiag diag.Diagnostics
+
+	*tags, tempDiag = types.SetValueFrom(ctx, types.Int64Type, IDs)
+	diags.Append(tempDiag...)
+}
+
+// TagLabels returns the labels of the given tag IDs.
+// The cached labels are reloaded once if some IDs are unknown.
+func (c *Client) TagLabels(ctx context.Context, tags types.Set, diags *diag.Diagnostics) types.Set {
+	if len(tags.Elements()) == 0 {
+		return types.SetValueMust(types.StringType, nil)
+	}
+
+	labelMap := c.TagLabelMap(ctx, diags)
+	if diags.HasError() {
+		return types.SetNull(types.StringType)
+	}
+
+	var IDs []int64
+
+	diags.Append(tags.ElementsAs(ctx, &IDs, true)...)
+
+	for _, ID := range IDs {
+		if _, ok := labelMap[ID]; !ok {
+			c.InvalidateTagLabels()
+			labelMap = c.TagLabelMap(ctx, diags)
+
+			break
+		}
+	}
+
+	return MapTagLabels(ctx, tags, labelMap, diags)
+}
+
+// MapTagLabels returns the labels of the given tag IDs using an already retrieved label map.
+// Unknown IDs are reported as warnings and left out of the labels.
+func MapTagLabels(ctx context.Context, tags types.Set, labelMap map[int64]string, diags *diag.Diagnostics) types.Set {
+	var IDs []int64
+
+	diags.Append(tags.ElementsAs(ctx, &IDs, true)...)
+
+	labels := make([]string, 0, len(IDs))
+
+	for _, ID := range IDs {
+		label, ok := labelMap[ID]
+		if !ok {
+			diags.AddWarning(NotFoundError, ParseNotFoundError(tagKind, "id", strconv.FormatInt(ID, 10)))
+
+			continue
+		}
+
+		labels = append(labels, label)
+	}
+
+	output, tempDiag := types.SetValueFrom(ctx, types.StringType, labels)
+	diags.Append(tempDiag...)
+
+	return output
+}
+
+func (c *Client) createTag(ctx context.Context, label string, diags *diag.Diagnostics) int64 {
+	if !c.AutoCreateTags {
+		diags.AddError(ResourceError, ParseNotFoundError(tagKind, "label", label))
+
+		return 0
+	}
+
+	request := whisparr.NewTagResource()
+	request.SetLabel(label)
+
+	response, _, err := c.TagApi.CreateTag(ctx).TagResource(*request).Execute()
+	if err != nil {
+		diags.Append(ParseClientDiagnostics(Create, tagKind, err)...)
+
+		return 0
+	}
+
+	c.InvalidateTagLabels()
+
+	return int64(response.GetId())
+}
+
+func findTagID(label string, labelMap map[int64]string) (int64, bool) {
+	for ID, l := range labelMap {
+		if l == label {
+			return ID, true
+		}
+	}
+
+	return 0, false
+}
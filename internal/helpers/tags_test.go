@@ -0,0 +1,209 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapTagLabels(t *testing.T) {
+	t.Parallel()
+
+	labelMap := map[int64]string{1: "one", 2: "two", 3: "three"}
+
+	tests := map[string]struct {
+		tags     []int64
+		expected []string
+		warnings int
+	}{
+		"empty": {
+			tags:     []int64{},
+			expected: []string{},
+		},
+		"found": {
+			tags:     []int64{1, 3},
+			expected: []string{"one", "three"},
+		},
+		"missing": {
+			tags:     []int64{2, 4},
+			expected: []string{"two"},
+			warnings: 1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			tags, _ := types.SetValueFrom(context.Background(), types.Int64Type, test.tags)
+			expected, _ := types.SetValueFrom(context.Background(), types.StringType, test.expected)
+			assert.Equal(t, expected, MapTagLabels(context.Background(), tags, labelMap, &diags))
+			assert.False(t, diags.HasError())
+			assert.Equal(t, test.warnings, diags.WarningsCount())
+		})
+	}
+}
+
+func TestFindTagID(t *testing.T) {
+	t.Parallel()
+
+	labelMap := map[int64]string{1: "one", 2: "two"}
+
+	tests := map[string]struct {
+		label    string
+		expected int64
+		found    bool
+	}{
+		"found": {
+			label:    "two",
+			expected: 2,
+			found:    true,
+		},
+		"missing": {
+			label:    "three",
+			expected: 0,
+			found:    false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ID, found := findTagID(test.label, labelMap)
+			assert.Equal(t, test.expected, ID)
+			assert.Equal(t, test.found, found)
+		})
+	}
+}
+
+// newTagTestClient returns a client backed by a fake tag API, counting the list calls.
+func newTagTestClient(t *testing.T, autoCreate bool, lists *int32) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case http.MethodGet:
+			atomic.AddInt32(lists, 1)
+			_, _ = w.Write([]byte(`[{"id":1,"label":"one"},{"id":2,"label":"two"}]`))
+		case http.MethodPost:
+			_, _ = w.Write([]byte(`{"id":3,"label":"three"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	config := whisparr.NewConfiguration()
+	config.Servers[0].URL = server.URL
+
+	return &Client{APIClient: whisparr.NewAPIClient(config), AutoCreateTags: autoCreate}
+}
+
+func TestTagLabels(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		tags     []int64
+		expected []string
+		lists    int32
+		warnings int
+	}{
+		"cached": {
+			tags:     []int64{1, 2},
+			expected: []string{"one", "two"},
+			lists:    1,
+		},
+		"unknown": {
+			tags:     []int64{1, 4},
+			expected: []string{"one"},
+			lists:    3,
+			warnings: 1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				diags diag.Diagnostics
+				lists int32
+			)
+
+			client := newTagTestClient(t, false, &lists)
+			tags, _ := types.SetValueFrom(context.Background(), types.Int64Type, test.tags)
+			expected, _ := types.SetValueFrom(context.Background(), types.StringType, test.expected)
+
+			// the second call is served by the cache, unknown IDs reload it every time
+			client.TagLabels(context.Background(), tags, &diags)
+			assert.Equal(t, expected, client.TagLabels(context.Background(), tags, &diags))
+			assert.False(t, diags.HasError())
+			assert.Equal(t, test.warnings, diags.WarningsCount())
+			assert.Equal(t, test.lists, atomic.LoadInt32(&lists))
+		})
+	}
+}
+
+func TestResolveTagLabels(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		labels     []string
+		expected   []int64
+		autoCreate bool
+		err        bool
+	}{
+		"existing": {
+			labels:   []string{"one", "two"},
+			expected: []int64{1, 2},
+		},
+		"missing": {
+			labels: []string{"one", "three"},
+			err:    true,
+		},
+		"auto create": {
+			labels:     []string{"one", "three"},
+			expected:   []int64{1, 3},
+			autoCreate: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				diags diag.Diagnostics
+				lists int32
+			)
+
+			client := newTagTestClient(t, test.autoCreate, &lists)
+			labels, _ := types.SetValueFrom(context.Background(), types.StringType, test.labels)
+			tags := types.SetNull(types.Int64Type)
+
+			client.ResolveTagLabels(context.Background(), labels, &tags, &diags)
+			assert.Equal(t, test.err, diags.HasError())
+
+			if test.err {
+				return
+			}
+
+			expected, _ := types.SetValueFrom(context.Background(), types.Int64Type, test.expected)
+			assert.Equal(t, expected, tags)
+		})
+	}
+}
@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
@@ -36,41 +36,32 @@ func ImportStatePassthroughIntID(ctx context.Context, attrPath path.Path, req re
 }
 
 // ResourceConfigure is a helper function to set the client for a specific resource.
-func ResourceConfigure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) *whisparr.APIClient {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
-		return nil
-	}
-
-	client, ok := req.ProviderData.(*whisparr.APIClient)
-	if !ok {
-		resp.Diagnostics.AddError(
-			UnexpectedResourceConfigureType,
-			fmt.Sprintf("Expected *whisparr.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
-		return nil
-	}
-
-	return client
+func ResourceConfigure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) *Client {
+	return providerData[Client](req.ProviderData, UnexpectedResourceConfigureType, &resp.Diagnostics)
 }
 
 // DataSourceConfigure is a helper function to set the client for a specific data source.
-func DataSourceConfigure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *whisparr.APIClient {
+func DataSourceConfigure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *Client {
+	return providerData[Client](req.ProviderData, UnexpectedDataSourceConfigureType, &resp.Diagnostics)
+}
+
+// providerData asserts the type of the data set by the provider configure:
+// the registry for the instance wrappers, the client selected by them for the wrapped implementations.
+func providerData[T Client | Registry](data any, summary string, diags *diag.Diagnostics) *T {
 	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
+	if data == nil {
 		return nil
 	}
 
-	client, ok := req.ProviderData.(*whisparr.APIClient)
+	typed, ok := data.(*T)
 	if !ok {
-		resp.Diagnostics.AddError(
-			UnexpectedDataSourceConfigureType,
-			fmt.Sprintf("Expected *whisparr.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		diags.AddError(
+			summary,
+			fmt.Sprintf("Expected %T, got: %T. Please report this issue to the provider developers.", typed, data),
 		)
 
 		return nil
 	}
 
-	return client
+	return typed
 }
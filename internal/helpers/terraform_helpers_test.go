@@ -16,17 +16,17 @@ func TestDataSourceConfigure(t *testing.T) {
 
 	var diags diag.Diagnostics
 
-	diags.AddError("Unexpected DataSource Configure Type", "Expected *whisparr.APIClient, got: string. Please report this issue to the provider developers.")
+	diags.AddError("Unexpected DataSource Configure Type", "Expected *helpers.Client, got: string. Please report this issue to the provider developers.")
 
 	tests := map[string]struct {
 		expected    any
 		errorString diag.Diagnostics
 	}{
 		"working": {
-			expected: whisparr.NewAPIClient(whisparr.NewConfiguration()),
+			expected: &Client{APIClient: whisparr.NewAPIClient(whisparr.NewConfiguration())},
 		},
 		"nil": {
-			expected: (*whisparr.APIClient)(nil),
+			expected: (*Client)(nil),
 		},
 		"error": {
 			expected:    "abc",
@@ -54,17 +54,17 @@ func TestResourceConfigure(t *testing.T) {
 
 	var diags diag.Diagnostics
 
-	diags.AddError("Unexpected Resource Configure Type", "Expected *whisparr.APIClient, got: string. Please report this issue to the provider developers.")
+	diags.AddError("Unexpected Resource Configure Type", "Expected *helpers.Client, got: string. Please report this issue to the provider developers.")
 
 	tests := map[string]struct {
 		expected    any
 		errorString diag.Diagnostics
 	}{
 		"working": {
-			expected: whisparr.NewAPIClient(whisparr.NewConfiguration()),
+			expected: &Client{APIClient: whisparr.NewAPIClient(whisparr.NewConfiguration())},
 		},
 		"nil": {
-			expected: (*whisparr.APIClient)(nil),
+			expected: (*Client)(nil),
 		},
 		"error": {
 			expected:    "abc",
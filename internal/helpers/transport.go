@@ -0,0 +1,136 @@
+package helpers
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// lockedRetries is the number of retries on SQLite lock errors.
+	lockedRetries = 5
+	// lockedBackoff is the base wait between retries, increased at each attempt.
+	lockedBackoff = 500 * time.Millisecond
+)
+
+// LimitTransport bounds the number of concurrent requests and their rate.
+type LimitTransport struct {
+	next      time.Time
+	Transport http.RoundTripper
+	slots     chan struct{}
+	interval  time.Duration
+	mu        sync.Mutex
+}
+
+// NewLimitTransport wraps the given transport with the limits, zero values mean no limit.
+func NewLimitTransport(transport http.RoundTripper, maxConcurrent int64, perSecond float64) *LimitTransport {
+	t := &LimitTransport{Transport: transport}
+
+	if maxConcurrent > 0 {
+		t.slots = make(chan struct{}, maxConcurrent)
+	}
+
+	if perSecond > 0 {
+		t.interval = time.Duration(float64(time.Second) / perSecond)
+	}
+
+	return t
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *LimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+			defer func() { <-t.slots }()
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	if wait := t.reserve(); wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	return t.Transport.RoundTrip(req)
+}
+
+// reserve books the next request slot, returning how long to wait for it.
+func (t *LimitTransport) reserve() time.Duration {
+	if t.interval == 0 {
+		return 0
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	if t.next.Before(now) {
+		t.next = now
+	}
+
+	wait := t.next.Sub(now)
+	t.next = t.next.Add(t.interval)
+
+	return wait
+}
+
+// RetryTransport retries the requests failed because the Whisparr database is locked.
+type RetryTransport struct {
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := t.Transport.RoundTrip(req)
+		if err != nil || resp.StatusCode != http.StatusInternalServerError || attempt > lockedRetries {
+			return resp, err
+		}
+
+		// A request body can only be sent again if it can be rebuilt.
+		if req.Body != nil && req.GetBody == nil {
+			return resp, nil
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !IsDatabaseLocked(body) {
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+
+			return resp, nil
+		}
+
+		timer := time.NewTimer(time.Duration(attempt) * lockedBackoff)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+
+			return nil, req.Context().Err()
+		}
+
+		if req.GetBody != nil {
+			retry := req.Clone(req.Context())
+			if retry.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+
+			req = retry
+		}
+	}
+}
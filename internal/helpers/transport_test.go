@@ -0,0 +1,141 @@
+package helpers
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryTransport(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		response string
+		failures int32
+		expected int32
+		status   int
+	}{
+		"locked": {
+			response: `{"message":"database is locked"}`,
+			failures: 1,
+			expected: 2,
+			status:   http.StatusOK,
+		},
+		"other error": {
+			response: `{"message":"other error"}`,
+			failures: 1,
+			expected: 1,
+			status:   http.StatusInternalServerError,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var hits int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				assert.Equal(t, `{"label":"test"}`, string(body))
+
+				if atomic.AddInt32(&hits, 1) <= test.failures {
+					w.WriteHeader(http.StatusInternalServerError)
+					_, _ = w.Write([]byte(test.response))
+				}
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: &RetryTransport{Transport: http.DefaultTransport}}
+			req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"label":"test"}`))
+			assert.Nil(t, err)
+
+			resp, err := client.Do(req)
+			assert.Nil(t, err)
+			resp.Body.Close()
+			assert.Equal(t, test.status, resp.StatusCode)
+			assert.Equal(t, test.expected, atomic.LoadInt32(&hits))
+		})
+	}
+}
+
+func TestLimitTransport(t *testing.T) {
+	t.Parallel()
+
+	var (
+		current int32
+		maximum int32
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&current, 1)
+		for {
+			m := atomic.LoadInt32(&maximum)
+			if n <= m || atomic.CompareAndSwapInt32(&maximum, m, n) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&current, -1)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewLimitTransport(http.DefaultTransport, 2, 100)}
+
+	var wg sync.WaitGroup
+
+	start := time.Now()
+
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			resp, err := client.Get(server.URL)
+			assert.Nil(t, err)
+			resp.Body.Close()
+		}()
+	}
+
+	wg.Wait()
+
+	assert.LessOrEqual(t, atomic.LoadInt32(&maximum), int32(2))
+	// 6 requests at 100 per second need at least 50ms.
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
+
+func TestIsDatabaseLocked(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body     string
+		expected bool
+	}{
+		"locked": {
+			body:     `{"message":"SQLite Error 5: 'database is locked'."}`,
+			expected: true,
+		},
+		"other": {
+			body:     `{"message":"not found"}`,
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, IsDatabaseLocked([]byte(test.body)))
+		})
+	}
+}
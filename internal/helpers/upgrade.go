@@ -0,0 +1,36 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// AdditiveUpgrader returns a state upgrader for schema versions only adding or removing attributes.
+// The prior state is decoded with the current schema: added attributes are null until the next read,
+// removed ones are dropped. Changing the type of an attribute needs a dedicated upgrader with its PriorSchema.
+func AdditiveUpgrader() resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil {
+				return
+			}
+
+			state, err := req.RawState.UnmarshalWithOpts(resp.State.Schema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+				ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+					IgnoreUndefinedAttributes: true,
+				},
+			})
+			if err != nil {
+				resp.Diagnostics.AddError(ResourceError, fmt.Sprintf("Unable to upgrade state, got error: %s", err))
+
+				return
+			}
+
+			resp.State.Raw = state
+		},
+	}
+}
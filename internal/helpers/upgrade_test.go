@@ -0,0 +1,156 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// testUpgradeResource upgrades version 0 states adding attributes.
+type testUpgradeResource struct {
+	testResource
+}
+
+func (r *testUpgradeResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: AdditiveUpgrader(),
+	}
+}
+
+// testPriorResource upgrades version 0 states with a dedicated upgrader.
+type testPriorResource struct {
+	testResource
+	prior schema.Schema
+}
+
+func (r *testPriorResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &r.prior,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var name string
+
+				resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &name)...)
+				resp.Diagnostics.Append(resp.State.Set(ctx, &testModel{ID: types.StringValue("1"), Name: types.StringValue(name)})...)
+			},
+		},
+	}
+}
+
+func TestAdditiveUpgrader(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		instance interface{}
+		state    string
+		wrapped  bool
+		err      bool
+	}{
+		"added attribute": {
+			state: `{"name":"test"}`,
+		},
+		"removed attribute": {
+			state: `{"id":"1","name":"test","removed":true}`,
+		},
+		"changed type": {
+			state: `{"id":["1"],"name":"test"}`,
+			err:   true,
+		},
+		"without instance": {
+			state:   `{"id":"1","name":"test"}`,
+			wrapped: true,
+		},
+		"with instance": {
+			state:    `{"id":"1","name":"test","instance":"other"}`,
+			instance: "other",
+			wrapped:  true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			var r resource.ResourceWithUpgradeState = &testUpgradeResource{}
+			if test.wrapped {
+				r = &instanceResource{Resource: &testUpgradeResource{}}
+			}
+
+			current := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, current)
+
+			resp := &resource.UpgradeStateResponse{
+				State: tfsdk.State{Schema: current.Schema},
+			}
+			r.UpgradeState(ctx)[0].StateUpgrader(ctx, resource.UpgradeStateRequest{
+				RawState: &tfprotov6.RawState{JSON: []byte(test.state)},
+			}, resp)
+
+			assert.Equal(t, test.err, resp.Diagnostics.HasError())
+
+			if test.err {
+				return
+			}
+
+			var state map[string]tftypes.Value
+			assert.NoError(t, resp.State.Raw.As(&state))
+			assert.True(t, state["name"].Equal(tftypes.NewValue(tftypes.String, "test")))
+
+			if test.wrapped {
+				assert.True(t, state[InstanceAttribute].Equal(tftypes.NewValue(tftypes.String, test.instance)))
+			}
+		})
+	}
+}
+
+// Ensure the prior schema of dedicated upgraders includes the instance attribute.
+func TestInstanceUpgradeStatePriorSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	prior := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{Required: true},
+		},
+	}
+
+	r := &instanceResource{Resource: &testPriorResource{prior: prior}}
+	upgrader := r.UpgradeState(ctx)[0]
+	_, ok := upgrader.PriorSchema.Attributes[InstanceAttribute]
+	assert.True(t, ok)
+
+	priorType := upgrader.PriorSchema.Type().TerraformType(ctx)
+	current := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, current)
+
+	resp := &resource.UpgradeStateResponse{
+		State: tfsdk.State{Schema: current.Schema},
+	}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{
+		State: &tfsdk.State{
+			Raw: tftypes.NewValue(priorType, map[string]tftypes.Value{
+				"name":            tftypes.NewValue(tftypes.String, "test"),
+				InstanceAttribute: tftypes.NewValue(tftypes.String, "other"),
+			}),
+			Schema: *upgrader.PriorSchema,
+		},
+	}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+
+	var state map[string]tftypes.Value
+	assert.NoError(t, resp.State.Raw.As(&state))
+	assert.True(t, state["id"].Equal(tftypes.NewValue(tftypes.String, "1")))
+	assert.True(t, state[InstanceAttribute].Equal(tftypes.NewValue(tftypes.String, "other")))
+}
@@ -30,7 +30,7 @@ func NewCustomFormatConditionDataSource() datasource.DataSource {
 
 // CustomFormatConditionDataSource defines the custom format condition implementation.
 type CustomFormatConditionDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // CustomFormatCondition describes the custom format condition data model.
@@ -153,6 +153,17 @@ func (c *CustomFormatCondition) write(ctx context.Context, spec *whisparr.Custom
 	helpers.WriteFields(ctx, c, spec.GetFields(), customFormatFields)
 }
 
+// same compares two conditions, unset fields are equal to their zero value as the API fills them with defaults.
+func (c CustomFormatCondition) same(other CustomFormatCondition) bool {
+	return c.Name.ValueString() == other.Name.ValueString() &&
+		c.Implementation.ValueString() == other.Implementation.ValueString() &&
+		c.Value.ValueString() == other.Value.ValueString() &&
+		c.Min.ValueInt64() == other.Min.ValueInt64() &&
+		c.Max.ValueInt64() == other.Max.ValueInt64() &&
+		c.Negate.ValueBool() == other.Negate.ValueBool() &&
+		c.Required.ValueBool() == other.Required.ValueBool()
+}
+
 func (c *CustomFormatCondition) read(ctx context.Context) *whisparr.CustomFormatSpecificationSchema {
 	spec := whisparr.NewCustomFormatSpecificationSchema()
 	spec.SetName(c.Name.ValueString())
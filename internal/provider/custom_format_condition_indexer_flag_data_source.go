@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -26,7 +25,7 @@ func NewCustomFormatConditionIndexerFlagDataSource() datasource.DataSource {
 
 // CustomFormatConditionIndexerFlagDataSource defines the custom format condition indexer flag implementation.
 type CustomFormatConditionIndexerFlagDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *CustomFormatConditionIndexerFlagDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -26,7 +25,7 @@ func NewCustomFormatConditionLanguageDataSource() datasource.DataSource {
 
 // CustomFormatConditionLanguageDataSource defines the custom_format_condition_language implementation.
 type CustomFormatConditionLanguageDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *CustomFormatConditionLanguageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
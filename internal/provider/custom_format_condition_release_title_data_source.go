@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -26,7 +25,7 @@ func NewCustomFormatConditionReleaseTitleDataSource() datasource.DataSource {
 
 // CustomFormatConditionReleaseTitleDataSource defines the custom_format_condition_release_title implementation.
 type CustomFormatConditionReleaseTitleDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *CustomFormatConditionReleaseTitleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
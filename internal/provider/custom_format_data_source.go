@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -22,7 +23,7 @@ func NewCustomFormatDataSource() datasource.DataSource {
 
 // CustomFormatDataSource defines the custom_format implementation.
 type CustomFormatDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *CustomFormatDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,6 +47,10 @@ func (d *CustomFormatDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				MarkdownDescription: "Custom Format ID.",
 				Computed:            true,
 			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "Custom Format JSON export, same format as the Whisparr UI.",
+				Computed:            true,
+			},
 			"specifications": schema.SetNestedAttribute{
 				MarkdownDescription: "Specifications.",
 				Computed:            true,
@@ -104,7 +109,7 @@ func (d *CustomFormatDataSource) Read(ctx context.Context, req datasource.ReadRe
 	// Get customFormat current value
 	response, _, err := d.client.CustomFormatApi.ListCustomFormat(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, customFormatDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, customFormatDataSourceName, err)...)
 
 		return
 	}
@@ -118,6 +123,7 @@ func (c *CustomFormat) find(ctx context.Context, name string, customFormats []*w
 	for _, i := range customFormats {
 		if i.GetName() == name {
 			c.write(ctx, i, diags)
+			c.JSON = types.StringValue(exportCustomFormatJSON(i, diags))
 
 			return
 		}
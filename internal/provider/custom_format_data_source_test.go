@@ -30,7 +30,8 @@ func TestAccCustomFormatDataSource(t *testing.T) {
 				Config: testAccCustomFormatResourceConfig("dataTest", "false") + testAccCustomFormatDataSourceConfig("whisparr_custom_format.test.name"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.whisparr_custom_format.test", "id"),
-					resource.TestCheckResourceAttr("data.whisparr_custom_format.test", "include_custom_format_when_renaming", "false")),
+					resource.TestCheckResourceAttr("data.whisparr_custom_format.test", "include_custom_format_when_renaming", "false"),
+					resource.TestCheckResourceAttrSet("data.whisparr_custom_format.test", "json")),
 			},
 		},
 	})
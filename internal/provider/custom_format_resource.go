@@ -2,10 +2,14 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -13,7 +17,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -21,33 +28,67 @@ const customFormatResourceName = "custom_format"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &CustomFormatResource{}
-	_ resource.ResourceWithImportState = &CustomFormatResource{}
+	_ resource.Resource                 = &CustomFormatResource{}
+	_ resource.ResourceWithImportState  = &CustomFormatResource{}
+	_ resource.ResourceWithUpgradeState = &CustomFormatResource{}
+
+	_ basetypes.StringTypable                    = CustomFormatJSONType{}
+	_ basetypes.StringValuableWithSemanticEquals = CustomFormatJSON{}
 )
 
+var errUnexpectedValueType = errors.New("unexpected value type")
+
 func NewCustomFormatResource() resource.Resource {
 	return &CustomFormatResource{}
 }
 
 // CustomFormatResource defines the custom format implementation.
 type CustomFormatResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // CustomFormat describes the custom format data model.
 type CustomFormat struct {
 	Specifications                  types.Set    `tfsdk:"specifications"`
 	Name                            types.String `tfsdk:"name"`
+	JSON                            types.String `tfsdk:"json"`
 	ID                              types.Int64  `tfsdk:"id"`
 	IncludeCustomFormatWhenRenaming types.Bool   `tfsdk:"include_custom_format_when_renaming"`
 }
 
+// ManagedCustomFormat describes the custom format resource data model.
+type ManagedCustomFormat struct {
+	Specifications                  types.Set        `tfsdk:"specifications"`
+	Name                            types.String     `tfsdk:"name"`
+	JSON                            CustomFormatJSON `tfsdk:"json"`
+	ID                              types.Int64      `tfsdk:"id"`
+	AdoptExisting                   types.Bool       `tfsdk:"adopt_existing"`
+	IncludeCustomFormatWhenRenaming types.Bool       `tfsdk:"include_custom_format_when_renaming"`
+}
+
+func (c ManagedCustomFormat) toCustomFormat() *CustomFormat {
+	return &CustomFormat{
+		Specifications:                  c.Specifications,
+		Name:                            c.Name,
+		ID:                              c.ID,
+		IncludeCustomFormatWhenRenaming: c.IncludeCustomFormatWhenRenaming,
+	}
+}
+
+func (c *ManagedCustomFormat) fromCustomFormat(customFormat *CustomFormat) {
+	c.Specifications = customFormat.Specifications
+	c.Name = customFormat.Name
+	c.ID = customFormat.ID
+	c.IncludeCustomFormatWhenRenaming = customFormat.IncludeCustomFormatWhenRenaming
+}
+
 func (c CustomFormat) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
 			"include_custom_format_when_renaming": types.BoolType,
 			"id":                                  types.Int64Type,
 			"name":                                types.StringType,
+			"json":                                types.StringType,
 			"specifications":                      types.SetType{}.WithElementType(CustomFormatCondition{}.getType()),
 		})
 }
@@ -59,6 +100,7 @@ func (r *CustomFormatResource) Metadata(_ context.Context, req resource.Metadata
 func (r *CustomFormatResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Profiles -->Custom Format resource.\nFor more information refer to [Custom Format](https://wiki.servarr.com/whisparr/settings#custom-formats).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"include_custom_format_when_renaming": schema.BoolAttribute{
 				MarkdownDescription: "Include custom format when renaming flag.",
@@ -69,6 +111,10 @@ func (r *CustomFormatResource) Schema(_ context.Context, _ resource.SchemaReques
 				MarkdownDescription: "Custom Format name.",
 				Required:            true,
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Adopt an existing custom format with the same name instead of failing on create. Defaults to the provider `adopt_existing` value.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Custom Format ID.",
 				Computed:            true,
@@ -77,11 +123,20 @@ func (r *CustomFormatResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"specifications": schema.SetNestedAttribute{
-				MarkdownDescription: "Specifications.",
-				Required:            true,
+				MarkdownDescription: "Specifications. Exactly one of `specifications` and `json` must be defined.",
+				Optional:            true,
+				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: r.getSpecificationSchema().Attributes,
 				},
+				Validators: []validator.Set{
+					setvalidator.ExactlyOneOf(path.MatchRoot("json")),
+				},
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "Custom Format exported JSON, as produced by the Whisparr UI or published by the community. Only the specifications and the renaming flag are used, `name` must still be set. Key order, formatting and specification order do not cause diffs. Exactly one of `specifications` and `json` must be defined.",
+				Optional:            true,
+				CustomType:          CustomFormatJSONType{},
 			},
 		},
 	}
@@ -130,6 +185,12 @@ func (r CustomFormatResource) getSpecificationSchema() schema.Schema {
 	}
 }
 
+func (r *CustomFormatResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *CustomFormatResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -138,20 +199,45 @@ func (r *CustomFormatResource) Configure(ctx context.Context, req resource.Confi
 
 func (r *CustomFormatResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var client *CustomFormat
+	var format *ManagedCustomFormat
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &format)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	format.readJSON(ctx, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := format.toCustomFormat()
+
 	// Create new CustomFormat
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.CustomFormatApi.CreateCustomFormat(ctx).CustomFormatResource(*request).Execute()
+	var (
+		response *whisparr.CustomFormatResource
+		err      error
+	)
+
+	// Adopt existing CustomFormat
+	existing := r.find(ctx, format, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if existing != nil {
+		request.SetId(existing.GetId())
+		response, _, err = r.client.CustomFormatApi.UpdateCustomFormat(ctx, strconv.Itoa(int(request.GetId()))).CustomFormatResource(*request).Execute()
+	} else {
+		response, _, err = r.client.CustomFormatApi.CreateCustomFormat(ctx).CustomFormatResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, customFormatResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Create, customFormatResourceName, err)...)
 
 		return
 	}
@@ -162,12 +248,13 @@ func (r *CustomFormatResource) Create(ctx context.Context, req resource.CreateRe
 	var state CustomFormat
 
 	state.write(ctx, response, &resp.Diagnostics)
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	format.fromCustomFormat(&state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, format)...)
 }
 
 func (r *CustomFormatResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var client CustomFormat
+	var client ManagedCustomFormat
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &client)...)
 
@@ -176,9 +263,11 @@ func (r *CustomFormatResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get CustomFormat current value
-	response, _, err := r.client.CustomFormatApi.GetCustomFormatById(ctx, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.CustomFormatApi.GetCustomFormatById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, customFormatResourceName, err))
+		if !helpers.RemoveNotFound(ctx, customFormatResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, customFormatResourceName, err)...)
+		}
 
 		return
 	}
@@ -189,25 +278,35 @@ func (r *CustomFormatResource) Read(ctx context.Context, req resource.ReadReques
 	var state CustomFormat
 
 	state.write(ctx, response, &resp.Diagnostics)
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	client.fromCustomFormat(&state)
+	client.writeJSON(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, client)...)
 }
 
 func (r *CustomFormatResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var client *CustomFormat
+	var format *ManagedCustomFormat
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &format)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	format.readJSON(ctx, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := format.toCustomFormat()
+
 	// Update CustomFormat
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.CustomFormatApi.UpdateCustomFormat(ctx, strconv.Itoa(int(request.GetId()))).CustomFormatResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, customFormatResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Update, customFormatResourceName, err)...)
 
 		return
 	}
@@ -218,7 +317,8 @@ func (r *CustomFormatResource) Update(ctx context.Context, req resource.UpdateRe
 	var state CustomFormat
 
 	state.write(ctx, response, &resp.Diagnostics)
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	format.fromCustomFormat(&state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, format)...)
 }
 
 func (r *CustomFormatResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -233,7 +333,7 @@ func (r *CustomFormatResource) Delete(ctx context.Context, req resource.DeleteRe
 	// Delete CustomFormat current value
 	_, err := r.client.CustomFormatApi.DeleteCustomFormat(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, customFormatResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Delete, customFormatResourceName, err)...)
 
 		return
 	}
@@ -247,19 +347,33 @@ func (r *CustomFormatResource) ImportState(ctx context.Context, req resource.Imp
 	tflog.Trace(ctx, "imported "+customFormatResourceName+": "+req.ID)
 }
 
-func (c *CustomFormat) write(ctx context.Context, customFormat *whisparr.CustomFormatResource, diags *diag.Diagnostics) {
-	var tempDiag diag.Diagnostics
+// find returns the custom format with the same name, if it has to be adopted.
+func (r *CustomFormatResource) find(ctx context.Context, customFormat *ManagedCustomFormat, diags *diag.Diagnostics) *whisparr.CustomFormatResource {
+	if !r.client.Adopt(customFormat.AdoptExisting) {
+		return nil
+	}
 
-	specs := make([]CustomFormatCondition, len(customFormat.Specifications))
-	for n, s := range customFormat.Specifications {
-		specs[n].write(ctx, s)
+	formats, _, err := r.client.CustomFormatApi.ListCustomFormat(ctx).Execute()
+	if err != nil {
+		diags.Append(helpers.ParseClientDiagnostics(helpers.List, customFormatResourceName, err)...)
+
+		return nil
+	}
+
+	for _, f := range formats {
+		if f.GetName() == customFormat.Name.ValueString() {
+			return f
+		}
 	}
 
+	return nil
+}
+
+func (c *CustomFormat) write(ctx context.Context, customFormat *whisparr.CustomFormatResource, diags *diag.Diagnostics) {
 	c.ID = types.Int64Value(int64(customFormat.GetId()))
 	c.Name = types.StringValue(customFormat.GetName())
 	c.IncludeCustomFormatWhenRenaming = types.BoolValue(customFormat.GetIncludeCustomFormatWhenRenaming())
-	c.Specifications, tempDiag = types.SetValueFrom(ctx, CustomFormatCondition{}.getType(), specs)
-	diags.Append(tempDiag...)
+	c.writeSpecifications(ctx, customFormat.GetSpecifications(), diags)
 }
 
 func (c *CustomFormat) read(ctx context.Context, diags *diag.Diagnostics) *whisparr.CustomFormatResource {
@@ -279,3 +393,285 @@ func (c *CustomFormat) read(ctx context.Context, diags *diag.Diagnostics) *whisp
 
 	return format
 }
+
+// customFormatExport is the custom format JSON format of the Whisparr UI export.
+type customFormatExport struct {
+	Name                            string                            `json:"name"`
+	IncludeCustomFormatWhenRenaming *bool                             `json:"includeCustomFormatWhenRenaming,omitempty"`
+	Specifications                  []customFormatExportSpecification `json:"specifications"`
+}
+
+type customFormatExportSpecification struct {
+	Name           string          `json:"name"`
+	Implementation string          `json:"implementation"`
+	Fields         json.RawMessage `json:"fields"`
+	Negate         bool            `json:"negate"`
+	Required       bool            `json:"required"`
+}
+
+// readJSON fills the specifications from the JSON attribute, if defined.
+func (c *ManagedCustomFormat) readJSON(ctx context.Context, diags *diag.Diagnostics) {
+	if c.JSON.IsNull() || c.JSON.IsUnknown() {
+		return
+	}
+
+	format, err := parseCustomFormatJSON(c.JSON.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("json"), helpers.ResourceError, fmt.Sprintf("Unable to parse %s json, got error: %s", customFormatResourceName, err))
+
+		return
+	}
+
+	if c.IncludeCustomFormatWhenRenaming.IsNull() || c.IncludeCustomFormatWhenRenaming.IsUnknown() {
+		c.IncludeCustomFormatWhenRenaming = types.BoolValue(format.GetIncludeCustomFormatWhenRenaming())
+	}
+
+	var parsed CustomFormat
+
+	parsed.writeSpecifications(ctx, format.GetSpecifications(), diags)
+	c.Specifications = parsed.Specifications
+}
+
+// writeJSON keeps the configured JSON as long as it matches the remote specifications, otherwise the remote export is used to surface the drift.
+func (c *ManagedCustomFormat) writeJSON(ctx context.Context, customFormat *whisparr.CustomFormatResource, diags *diag.Diagnostics) {
+	if c.JSON.IsNull() {
+		return
+	}
+
+	format, err := parseCustomFormatJSON(c.JSON.ValueString())
+	if err == nil && sameSpecifications(ctx, format.GetSpecifications(), customFormat.GetSpecifications()) {
+		return
+	}
+
+	c.JSON = CustomFormatJSON{StringValue: types.StringValue(exportCustomFormatJSON(customFormat, diags))}
+}
+
+// sameSpecifications compares specifications regardless of their order and of the unset fields the API fills with defaults.
+func sameSpecifications(ctx context.Context, expected, actual []*whisparr.CustomFormatSpecificationSchema) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+
+	matched := make([]bool, len(actual))
+
+	for _, e := range expected {
+		var want CustomFormatCondition
+
+		want.write(ctx, e)
+
+		found := false
+
+		for n, a := range actual {
+			if matched[n] {
+				continue
+			}
+
+			var got CustomFormatCondition
+
+			got.write(ctx, a)
+
+			if want.same(got) {
+				matched[n] = true
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *CustomFormat) writeSpecifications(ctx context.Context, specifications []*whisparr.CustomFormatSpecificationSchema, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	specs := make([]CustomFormatCondition, len(specifications))
+	for n, s := range specifications {
+		specs[n].write(ctx, s)
+	}
+
+	c.Specifications, tempDiag = types.SetValueFrom(ctx, CustomFormatCondition{}.getType(), specs)
+	diags.Append(tempDiag...)
+}
+
+// parseCustomFormatJSON converts an exported custom format, supporting fields both as object and as list of name/value pairs.
+func parseCustomFormatJSON(data string) (*whisparr.CustomFormatResource, error) {
+	var export customFormatExport
+	if err := json.Unmarshal([]byte(data), &export); err != nil {
+		return nil, err
+	}
+
+	format := whisparr.NewCustomFormatResource()
+	format.SetName(export.Name)
+	format.SetIncludeCustomFormatWhenRenaming(export.IncludeCustomFormatWhenRenaming != nil && *export.IncludeCustomFormatWhenRenaming)
+
+	specs := make([]*whisparr.CustomFormatSpecificationSchema, len(export.Specifications))
+
+	for n, s := range export.Specifications {
+		fields, err := s.fields()
+		if err != nil {
+			return nil, err
+		}
+
+		specs[n] = whisparr.NewCustomFormatSpecificationSchema()
+		specs[n].SetName(s.Name)
+		specs[n].SetImplementation(s.Implementation)
+		specs[n].SetNegate(s.Negate)
+		specs[n].SetRequired(s.Required)
+		specs[n].SetFields(fields)
+	}
+
+	format.SetSpecifications(specs)
+
+	return format, nil
+}
+
+func (s customFormatExportSpecification) fields() ([]*whisparr.Field, error) {
+	var fields []*whisparr.Field
+
+	if len(s.Fields) == 0 || string(s.Fields) == "null" {
+		return fields, nil
+	}
+
+	values := make(map[string]interface{})
+	if err := json.Unmarshal(s.Fields, &values); err == nil {
+		for name, value := range values {
+			field := whisparr.NewField()
+			field.SetName(name)
+			field.SetValue(value)
+			fields = append(fields, field)
+		}
+
+		return fields, nil
+	}
+
+	if err := json.Unmarshal(s.Fields, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// exportCustomFormatJSON renders the custom format as the Whisparr UI export.
+func exportCustomFormatJSON(customFormat *whisparr.CustomFormatResource, diags *diag.Diagnostics) string {
+	renaming := customFormat.GetIncludeCustomFormatWhenRenaming()
+	export := customFormatExport{
+		Name:                            customFormat.GetName(),
+		IncludeCustomFormatWhenRenaming: &renaming,
+		Specifications:                  make([]customFormatExportSpecification, len(customFormat.GetSpecifications())),
+	}
+
+	for n, s := range customFormat.GetSpecifications() {
+		values := make(map[string]interface{}, len(s.GetFields()))
+		for _, f := range s.GetFields() {
+			values[f.GetName()] = f.GetValue()
+		}
+
+		fields, err := json.Marshal(values)
+		if err != nil {
+			diags.AddError(helpers.ResourceError, fmt.Sprintf("Unable to export %s json, got error: %s", customFormatResourceName, err))
+		}
+
+		export.Specifications[n] = customFormatExportSpecification{
+			Name:           s.GetName(),
+			Implementation: s.GetImplementation(),
+			Negate:         s.GetNegate(),
+			Required:       s.GetRequired(),
+			Fields:         fields,
+		}
+	}
+
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		diags.AddError(helpers.ResourceError, fmt.Sprintf("Unable to export %s json, got error: %s", customFormatResourceName, err))
+	}
+
+	return string(data)
+}
+
+// CustomFormatJSONType is the type of the custom format JSON, whose values are equal when they define the same custom format.
+type CustomFormatJSONType struct {
+	basetypes.StringType
+}
+
+func (t CustomFormatJSONType) Equal(o attr.Type) bool {
+	other, ok := o.(CustomFormatJSONType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t CustomFormatJSONType) String() string {
+	return "CustomFormatJSONType"
+}
+
+func (t CustomFormatJSONType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return CustomFormatJSON{StringValue: in}, nil
+}
+
+func (t CustomFormatJSONType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	value, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := value.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", errUnexpectedValueType, value)
+	}
+
+	return CustomFormatJSON{StringValue: stringValue}, nil
+}
+
+func (t CustomFormatJSONType) ValueType(_ context.Context) attr.Value {
+	return CustomFormatJSON{}
+}
+
+// CustomFormatJSON is a custom format JSON value.
+type CustomFormatJSON struct {
+	basetypes.StringValue
+}
+
+func (v CustomFormatJSON) Type(_ context.Context) attr.Type {
+	return CustomFormatJSONType{}
+}
+
+func (v CustomFormatJSON) Equal(o attr.Value) bool {
+	other, ok := o.(CustomFormatJSON)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals compares the renaming flag and the specifications, regardless of key order, formatting and specification order.
+func (v CustomFormatJSON) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(CustomFormatJSON)
+	if !ok {
+		diags.AddError(helpers.ResourceError, fmt.Sprintf("%s: %T", errUnexpectedValueType, newValuable))
+
+		return false, diags
+	}
+
+	prior, err := parseCustomFormatJSON(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	current, err := parseCustomFormatJSON(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return prior.GetIncludeCustomFormatWhenRenaming() == current.GetIncludeCustomFormatWhenRenaming() &&
+		sameSpecifications(ctx, prior.GetSpecifications(), current.GetSpecifications()), diags
+}
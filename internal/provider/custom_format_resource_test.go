@@ -1,11 +1,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccCustomFormatResource(t *testing.T) {
@@ -46,6 +50,23 @@ func TestAccCustomFormatResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// JSON testing
+			{
+				Config: testAccCustomFormatResourceJSONConfig("resourceTest"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("whisparr_custom_format.test", "include_custom_format_when_renaming", "true"),
+					resource.TestCheckResourceAttr("whisparr_custom_format.test", "specifications.#", "2"),
+				),
+			},
+			// JSON reordered testing
+			{
+				Config: testAccCustomFormatResourceReorderedJSONConfig("resourceTest"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -83,3 +104,169 @@ func testAccCustomFormatResourceConfig(name, enable string) string {
 		]
 	}`, enable, name)
 }
+
+func testAccCustomFormatResourceJSONConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "whisparr_custom_format" "test" {
+		name = "%s"
+		json = jsonencode({
+			trash_id = "test"
+			name = "Imported"
+			includeCustomFormatWhenRenaming = true
+			specifications = [
+				{
+					name = "Surround Sound"
+					implementation = "ReleaseTitleSpecification"
+					negate = false
+					required = false
+					fields = {
+						value = "DTS.?(HD|ES|X(?!\\D))|TRUEHD|ATMOS|DD(\\+|P).?([5-9])|EAC3.?([5-9])"
+					}
+				},
+				{
+					name = "Size"
+					implementation = "SizeSpecification"
+					negate = false
+					required = false
+					fields = [
+						{ name = "min", value = 0 },
+						{ name = "max", value = 100 }
+					]
+				}
+			]
+		})
+	}`, name)
+}
+
+func testAccCustomFormatResourceReorderedJSONConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "whisparr_custom_format" "test" {
+		name = "%s"
+		json = <<-EOT
+		{
+			"specifications": [
+				{
+					"implementation": "SizeSpecification",
+					"name": "Size",
+					"fields": [{"value": 0, "name": "min"}, {"value": 100, "name": "max"}]
+				},
+				{
+					"required": false,
+					"negate": false,
+					"implementation": "ReleaseTitleSpecification",
+					"name": "Surround Sound",
+					"fields": {"value": "DTS.?(HD|ES|X(?!\\D))|TRUEHD|ATMOS|DD(\\+|P).?([5-9])|EAC3.?([5-9])"}
+				}
+			],
+			"includeCustomFormatWhenRenaming": true,
+			"name": "Imported"
+		}
+		EOT
+	}`, name)
+}
+
+func TestCustomFormatJSONSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	prior := `{"name":"test","includeCustomFormatWhenRenaming":true,"specifications":[` +
+		`{"name":"size","implementation":"SizeSpecification","fields":{"min":1,"max":10}},` +
+		`{"name":"x265","implementation":"ReleaseTitleSpecification","required":true,"fields":{"value":"x265"}}]}`
+
+	tests := map[string]struct {
+		current string
+		equal   bool
+	}{
+		"reordered keys": {
+			current: `{"specifications":[` +
+				`{"fields":{"max":10,"min":1},"implementation":"SizeSpecification","name":"size"},` +
+				`{"required":true,"fields":{"value":"x265"},"implementation":"ReleaseTitleSpecification","name":"x265"}],` +
+				`"includeCustomFormatWhenRenaming":true,"name":"test"}`,
+			equal: true,
+		},
+		"reformatted and reordered specifications": {
+			current: `{
+				"name": "test",
+				"includeCustomFormatWhenRenaming": true,
+				"specifications": [
+					{"name": "x265", "implementation": "ReleaseTitleSpecification", "required": true, "fields": {"value": "x265"}},
+					{"name": "size", "implementation": "SizeSpecification", "fields": {"min": 1, "max": 10}}
+				]
+			}`,
+			equal: true,
+		},
+		"changed value": {
+			current: `{"name":"test","includeCustomFormatWhenRenaming":true,"specifications":[` +
+				`{"name":"size","implementation":"SizeSpecification","fields":{"min":1,"max":10}},` +
+				`{"name":"x265","implementation":"ReleaseTitleSpecification","required":true,"fields":{"value":"x264"}}]}`,
+			equal: false,
+		},
+		"changed renaming": {
+			current: `{"name":"test","includeCustomFormatWhenRenaming":false,"specifications":[` +
+				`{"name":"size","implementation":"SizeSpecification","fields":{"min":1,"max":10}},` +
+				`{"name":"x265","implementation":"ReleaseTitleSpecification","required":true,"fields":{"value":"x265"}}]}`,
+			equal: false,
+		},
+		"invalid": {
+			current: `{"name":`,
+			equal:   false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			equal, diags := CustomFormatJSON{StringValue: types.StringValue(prior)}.
+				StringSemanticEquals(context.Background(), CustomFormatJSON{StringValue: types.StringValue(test.current)})
+			assert.False(t, diags.HasError())
+			assert.Equal(t, test.equal, equal)
+		})
+	}
+}
+
+func TestSameSpecifications(t *testing.T) {
+	t.Parallel()
+
+	config := `{"name":"test","specifications":[` +
+		`{"name":"size","implementation":"SizeSpecification","fields":{"min":1,"max":10}},` +
+		`{"name":"x265","implementation":"ReleaseTitleSpecification","required":true,"fields":{"value":"x265"}}]}`
+
+	tests := map[string]struct {
+		remote string
+		same   bool
+	}{
+		"reordered with defaults": {
+			remote: `{"name":"test","specifications":[` +
+				`{"name":"x265","implementation":"ReleaseTitleSpecification","negate":false,"required":true,"fields":[{"name":"value","value":"x265"}]},` +
+				`{"name":"size","implementation":"SizeSpecification","negate":false,"required":false,"fields":[{"name":"min","value":1},{"name":"max","value":10}]}]}`,
+			same: true,
+		},
+		"changed value": {
+			remote: `{"name":"test","specifications":[` +
+				`{"name":"x265","implementation":"ReleaseTitleSpecification","required":true,"fields":{"value":"x264"}},` +
+				`{"name":"size","implementation":"SizeSpecification","fields":{"min":1,"max":10}}]}`,
+			same: false,
+		},
+		"missing specification": {
+			remote: `{"name":"test","specifications":[` +
+				`{"name":"size","implementation":"SizeSpecification","fields":{"min":1,"max":10}}]}`,
+			same: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expected, err := parseCustomFormatJSON(config)
+			assert.NoError(t, err)
+
+			actual, err := parseCustomFormatJSON(test.remote)
+			assert.NoError(t, err)
+
+			assert.Equal(t, test.same, sameSpecifications(context.Background(), expected.GetSpecifications(), actual.GetSpecifications()))
+		})
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,7 +22,7 @@ func NewCustomFormatsDataSource() datasource.DataSource {
 
 // CustomFormatsDataSource defines the custom formats implementation.
 type CustomFormatsDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // CustomFormats describes the custom formats data model.
@@ -62,6 +61,10 @@ func (d *CustomFormatsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 							MarkdownDescription: "Custom Format ID.",
 							Computed:            true,
 						},
+						"json": schema.StringAttribute{
+							MarkdownDescription: "Custom Format JSON export, same format as the Whisparr UI.",
+							Computed:            true,
+						},
 						"specifications": schema.SetNestedAttribute{
 							MarkdownDescription: "Specifications.",
 							Computed:            true,
@@ -116,7 +119,7 @@ func (d *CustomFormatsDataSource) Read(ctx context.Context, _ datasource.ReadReq
 	// Get custom formatss current value
 	response, _, err := d.client.CustomFormatApi.ListCustomFormat(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, customFormatsDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.List, customFormatsDataSourceName, err)...)
 
 		return
 	}
@@ -126,6 +129,7 @@ func (d *CustomFormatsDataSource) Read(ctx context.Context, _ datasource.ReadReq
 	formats := make([]CustomFormat, len(response))
 	for i, p := range response {
 		formats[i].write(ctx, p, &resp.Diagnostics)
+		formats[i].JSON = types.StringValue(exportCustomFormatJSON(p, &resp.Diagnostics))
 	}
 
 	formatList, diags := types.SetValueFrom(ctx, CustomFormat{}.getType(), formats)
@@ -24,7 +24,7 @@ func NewDelayProfileDataSource() datasource.DataSource {
 
 // DelayProfileDataSource defines the delay profile implementation.
 type DelayProfileDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *DelayProfileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -60,6 +60,10 @@ func (d *DelayProfileDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				MarkdownDescription: "Torrent Delay.",
 				Computed:            true,
 			},
+			"default_profile": schema.BoolAttribute{
+				MarkdownDescription: "Default profile flag.",
+				Computed:            true,
+			},
 			"order": schema.Int64Attribute{
 				MarkdownDescription: "Order.",
 				Computed:            true,
@@ -69,6 +73,11 @@ func (d *DelayProfileDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"preferred_protocol": schema.StringAttribute{
 				MarkdownDescription: "Preferred protocol.",
 				Computed:            true,
@@ -94,12 +103,13 @@ func (d *DelayProfileDataSource) Read(ctx context.Context, req datasource.ReadRe
 	// Get delayprofiles current value
 	response, _, err := d.client.DelayProfileApi.ListDelayProfile(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, delayProfileDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, delayProfileDataSourceName, err)...)
 
 		return
 	}
 
 	data.find(ctx, data.ID.ValueInt64(), response, &resp.Diagnostics)
+	data.TagLabels = d.client.TagLabels(ctx, data.Tags, &resp.Diagnostics)
 
 	tflog.Trace(ctx, "read "+delayProfileDataSourceName)
 	// Map response body to resource schema attribute
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
+	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const delayProfileOrderResourceName = "delay_profile_order"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DelayProfileOrderResource{}
+
+func NewDelayProfileOrderResource() resource.Resource {
+	return &DelayProfileOrderResource{}
+}
+
+// DelayProfileOrderResource defines the delay profile order implementation.
+type DelayProfileOrderResource struct {
+	client *helpers.Client
+}
+
+// DelayProfileOrder describes the delay profile order data model.
+type DelayProfileOrder struct {
+	IDs types.List   `tfsdk:"ids"`
+	ID  types.String `tfsdk:"id"`
+}
+
+func (r *DelayProfileOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + delayProfileOrderResourceName
+}
+
+func (r *DelayProfileOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Profiles -->Delay Profile Order resource.\nSets the precedence of the given [Delay Profiles](delay_profile), the first one has the highest precedence. The default profile is always the last one.\n" +
+			"The profiles not listed are moved after the listed ones, destroying the resource only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "Delay Profile IDs, in order of precedence. The default profile cannot be included.",
+				Required:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueInt64sAre(int64validator.NoneOf(delayProfileDefaultID)),
+				},
+			},
+		},
+	}
+}
+
+func (r *DelayProfileOrderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *DelayProfileOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var order *DelayProfileOrder
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &order)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reorder DelayProfiles
+	r.reorder(ctx, order, helpers.Create, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+delayProfileOrderResourceName+": "+order.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &order)...)
+}
+
+func (r *DelayProfileOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var order *DelayProfileOrder
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &order)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get delayprofiles current value
+	response, _, err := r.client.DelayProfileApi.ListDelayProfile(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, delayProfileOrderResourceName, err)...)
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+delayProfileOrderResourceName+": "+order.ID.ValueString())
+	// Map response body to resource schema attribute
+	order.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &order)...)
+}
+
+func (r *DelayProfileOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var order *DelayProfileOrder
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &order)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reorder DelayProfiles
+	r.reorder(ctx, order, helpers.Update, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+delayProfileOrderResourceName+": "+order.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &order)...)
+}
+
+func (r *DelayProfileOrderResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Order is kept as is
+	tflog.Trace(ctx, "decoupled "+delayProfileOrderResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+// reorder moves each profile after the previous one, the first one to the top.
+// The reorder endpoint is not modeled by the SDK.
+func (r *DelayProfileOrderResource) reorder(ctx context.Context, order *DelayProfileOrder, action string, diags *diag.Diagnostics) {
+	var ids []int64
+
+	diags.Append(order.IDs.ElementsAs(ctx, &ids, false)...)
+
+	for n, id := range ids {
+		path := fmt.Sprintf("/api/v3/delayprofile/reorder/%d", id)
+		if n > 0 {
+			path += fmt.Sprintf("?afterId=%d", ids[n-1])
+		}
+
+		if err := r.client.Request(ctx, http.MethodPut, path, nil, nil); err != nil {
+			diags.Append(helpers.ParseClientDiagnostics(action, delayProfileOrderResourceName, err)...)
+
+			return
+		}
+	}
+
+	response, _, err := r.client.DelayProfileApi.ListDelayProfile(ctx).Execute()
+	if err != nil {
+		diags.Append(helpers.ParseClientDiagnostics(helpers.Read, delayProfileOrderResourceName, err)...)
+
+		return
+	}
+
+	order.write(ctx, response, diags)
+}
+
+// write keeps the managed IDs still existing, sorted by their current order.
+func (o *DelayProfileOrder) write(ctx context.Context, profiles []*whisparr.DelayProfileResource, diags *diag.Diagnostics) {
+	var (
+		ids      []int64
+		tempDiag diag.Diagnostics
+	)
+
+	diags.Append(o.IDs.ElementsAs(ctx, &ids, false)...)
+
+	orders := make(map[int64]int32, len(profiles))
+	for _, p := range profiles {
+		orders[int64(p.GetId())] = p.GetOrder()
+	}
+
+	sorted := make([]int64, 0, len(ids))
+
+	for _, id := range ids {
+		if _, ok := orders[id]; ok {
+			sorted = append(sorted, id)
+		}
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return orders[sorted[i]] < orders[sorted[j]]
+	})
+
+	o.ID = types.StringValue(strconv.Itoa(len(sorted)))
+	o.IDs, tempDiag = types.ListValueFrom(ctx, types.Int64Type, sorted)
+	diags.Append(tempDiag...)
+}
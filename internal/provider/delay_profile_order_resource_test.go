@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDelayProfileOrderResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccDelayProfileOrderResourceConfig("[2, 3]") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccDelayProfileOrderResourceConfig("[whisparr_delay_profile.first.id, whisparr_delay_profile.second.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("whisparr_delay_profile_order.test", "ids.0", "whisparr_delay_profile.first", "id"),
+					resource.TestCheckResourceAttrPair("whisparr_delay_profile_order.test", "ids.1", "whisparr_delay_profile.second", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccDelayProfileOrderResourceConfig("[2, 3]") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccDelayProfileOrderResourceConfig("[whisparr_delay_profile.second.id, whisparr_delay_profile.first.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("whisparr_delay_profile_order.test", "ids.0", "whisparr_delay_profile.second", "id"),
+					resource.TestCheckResourceAttrPair("whisparr_delay_profile_order.test", "ids.1", "whisparr_delay_profile.first", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDelayProfileOrderResourceConfig(ids string) string {
+	return fmt.Sprintf(`
+	resource "whisparr_tag" "first" {
+		label = "delay_order_first"
+	}
+
+	resource "whisparr_tag" "second" {
+		label = "delay_order_second"
+	}
+
+	resource "whisparr_delay_profile" "first" {
+		enable_usenet = true
+		enable_torrent = true
+		preferred_protocol= "usenet"
+		tags = [whisparr_tag.first.id]
+	}
+
+	resource "whisparr_delay_profile" "second" {
+		enable_usenet = true
+		enable_torrent = true
+		preferred_protocol= "torrent"
+		tags = [whisparr_tag.second.id]
+	}
+
+	resource "whisparr_delay_profile_order" "test" {
+		ids = %s
+	}`, ids)
+}
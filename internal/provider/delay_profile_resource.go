@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -19,12 +21,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-const delayProfileResourceName = "delay_profile"
+const (
+	delayProfileResourceName = "delay_profile"
+	delayProfileDefaultID    = 1
+)
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &DelayProfileResource{}
-	_ resource.ResourceWithImportState = &DelayProfileResource{}
+	_ resource.Resource                 = &DelayProfileResource{}
+	_ resource.ResourceWithImportState  = &DelayProfileResource{}
+	_ resource.ResourceWithUpgradeState = &DelayProfileResource{}
 )
 
 func NewDelayProfileResource() resource.Resource {
@@ -33,12 +39,13 @@ func NewDelayProfileResource() resource.Resource {
 
 // DelayProfileResource defines the delay profile implementation.
 type DelayProfileResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // DelayProfile describes the delay profile data model.
 type DelayProfile struct {
 	Tags                   types.Set    `tfsdk:"tags"`
+	TagLabels              types.Set    `tfsdk:"tag_labels"`
 	PreferredProtocol      types.String `tfsdk:"preferred_protocol"`
 	UsenetDelay            types.Int64  `tfsdk:"usenet_delay"`
 	TorrentDelay           types.Int64  `tfsdk:"torrent_delay"`
@@ -47,12 +54,14 @@ type DelayProfile struct {
 	EnableUsenet           types.Bool   `tfsdk:"enable_usenet"`
 	EnableTorrent          types.Bool   `tfsdk:"enable_torrent"`
 	BypassIfHighestQuality types.Bool   `tfsdk:"bypass_if_highest_quality"`
+	DefaultProfile         types.Bool   `tfsdk:"default_profile"`
 }
 
 func (p DelayProfile) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
 			"tags":                      types.SetType{}.WithElementType(types.Int64Type),
+			"tag_labels":                types.SetType{}.WithElementType(types.StringType),
 			"preferred_protocol":        types.StringType,
 			"usenet_delay":              types.Int64Type,
 			"torrent_delay":             types.Int64Type,
@@ -61,6 +70,7 @@ func (p DelayProfile) getType() attr.Type {
 			"enable_usenet":             types.BoolType,
 			"enable_torrent":            types.BoolType,
 			"bypass_if_highest_quality": types.BoolType,
+			"default_profile":           types.BoolType,
 		})
 }
 
@@ -71,6 +81,7 @@ func (r *DelayProfileResource) Metadata(_ context.Context, req resource.Metadata
 func (r *DelayProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Profiles -->Delay Profile resource.\nFor more information refer to [Delay Profiles](https://wiki.servarr.com/whisparr/settings#delay-profiles) documentation.",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Delay Profile ID.",
@@ -105,15 +116,33 @@ func (r *DelayProfileResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 			},
 			"order": schema.Int64Attribute{
-				MarkdownDescription: "Order.",
+				MarkdownDescription: "Order, assigned by Whisparr on create. Use [Delay Profile Order](delay_profile_order) to change the precedence.",
+				Computed:            true,
+			},
+			"default_profile": schema.BoolAttribute{
+				MarkdownDescription: "Adopt the default delay profile instead of creating a new one. It must have no tags and it is only removed from the state on destroy.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
 			},
 			"tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Exactly one of `tags` and `tag_labels` must be defined.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ExactlyOneOf(path.MatchRoot("tags")),
+				},
+			},
 			"preferred_protocol": schema.StringAttribute{
 				MarkdownDescription: "Preferred protocol.",
 				Optional:            true,
@@ -126,6 +155,12 @@ func (r *DelayProfileResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func (r *DelayProfileResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *DelayProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -142,34 +177,66 @@ func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, profile.TagLabels, &profile.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build Create resource
 	request := profile.read(ctx, &resp.Diagnostics)
 
-	// Create new DelayProfile
+	// Default profile cannot be created, it is updated keeping its order
+	if profile.DefaultProfile.ValueBool() {
+		r.adoptDefault(ctx, profile, request, &resp.Diagnostics)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
+
+		return
+	}
+
+	// Create new DelayProfile, order is assigned by Whisparr
 	response, _, err := r.client.DelayProfileApi.CreateDelayProfile(ctx).DelayProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, delayProfileResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Create, delayProfileResourceName, err)...)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created"+delayProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 
-	// Set order on create
-	if !profile.Order.IsUnknown() {
-		response.Order = request.Order
+	// Generate resource state struct
+	profile.write(ctx, response, &resp.Diagnostics)
+	profile.TagLabels = r.client.TagLabels(ctx, profile.Tags, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
+}
+
+func (r *DelayProfileResource) adoptDefault(ctx context.Context, profile *DelayProfile, request *whisparr.DelayProfileResource, diags *diag.Diagnostics) {
+	current, _, err := r.client.DelayProfileApi.GetDelayProfileById(ctx, delayProfileDefaultID).Execute()
+	if err != nil {
+		diags.Append(helpers.ParseClientDiagnostics(helpers.Create, delayProfileResourceName, err)...)
+
+		return
+	}
 
-		response, _, err = r.client.DelayProfileApi.UpdateDelayProfile(ctx, strconv.Itoa(int(response.GetId()))).DelayProfileResource(*response).Execute()
-		if err != nil {
-			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, delayProfileResourceName, err))
+	request.SetId(delayProfileDefaultID)
+	request.SetOrder(current.GetOrder())
 
-			return
-		}
+	response, _, err := r.client.DelayProfileApi.UpdateDelayProfile(ctx, strconv.Itoa(delayProfileDefaultID)).DelayProfileResource(*request).Execute()
+	if err != nil {
+		diags.Append(helpers.ParseClientDiagnostics(helpers.Create, delayProfileResourceName, err)...)
+
+		return
 	}
 
+	tflog.Trace(ctx, "adopted "+delayProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
-	profile.write(ctx, response, &resp.Diagnostics)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
+	profile.write(ctx, response, diags)
+	profile.TagLabels = r.client.TagLabels(ctx, profile.Tags, diags)
 }
 
 func (r *DelayProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -183,9 +250,11 @@ func (r *DelayProfileResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get delayprofile current value
-	response, _, err := r.client.DelayProfileApi.GetDelayProfileById(ctx, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DelayProfileApi.GetDelayProfileById(ctx, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, delayProfileResourceName, err))
+		if !helpers.RemoveNotFound(ctx, delayProfileResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, delayProfileResourceName, err)...)
+		}
 
 		return
 	}
@@ -193,6 +262,7 @@ func (r *DelayProfileResource) Read(ctx context.Context, req resource.ReadReques
 	tflog.Trace(ctx, "read "+delayProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	profile.write(ctx, response, &resp.Diagnostics)
+	profile.TagLabels = r.client.TagLabels(ctx, profile.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
 }
 
@@ -206,13 +276,29 @@ func (r *DelayProfileResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, profile.TagLabels, &profile.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build Update resource
 	request := profile.read(ctx, &resp.Diagnostics)
 
+	// Order is managed by the delay profile order, keep the current one
+	current, _, err := r.client.DelayProfileApi.GetDelayProfileById(ctx, request.GetId()).Execute()
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Update, delayProfileResourceName, err)...)
+
+		return
+	}
+
+	request.SetOrder(current.GetOrder())
+
 	// Update DelayProfile
 	response, _, err := r.client.DelayProfileApi.UpdateDelayProfile(ctx, strconv.Itoa(int(request.GetId()))).DelayProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, delayProfileResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Update, delayProfileResourceName, err)...)
 
 		return
 	}
@@ -220,6 +306,7 @@ func (r *DelayProfileResource) Update(ctx context.Context, req resource.UpdateRe
 	tflog.Trace(ctx, "updated "+delayProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	profile.write(ctx, response, &resp.Diagnostics)
+	profile.TagLabels = r.client.TagLabels(ctx, profile.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
 }
 
@@ -232,10 +319,18 @@ func (r *DelayProfileResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	// Default profile cannot be deleted
+	if ID == delayProfileDefaultID {
+		tflog.Trace(ctx, "decoupled "+delayProfileResourceName+": "+strconv.Itoa(int(ID)))
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
 	// Delete delayprofile current value
 	_, err := r.client.DelayProfileApi.DeleteDelayProfile(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, delayProfileResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Delete, delayProfileResourceName, err)...)
 
 		return
 	}
@@ -259,6 +354,7 @@ func (p *DelayProfile) write(ctx context.Context, profile *whisparr.DelayProfile
 	p.UsenetDelay = types.Int64Value(int64(profile.GetUsenetDelay()))
 	p.TorrentDelay = types.Int64Value(int64(profile.GetTorrentDelay()))
 	p.Order = types.Int64Value(int64(profile.GetOrder()))
+	p.DefaultProfile = types.BoolValue(profile.GetId() == delayProfileDefaultID)
 	p.PreferredProtocol = types.StringValue(string(*profile.PreferredProtocol))
 	p.Tags, tempDiag = types.SetValueFrom(ctx, types.Int64Type, profile.GetTags())
 	diags.Append(tempDiag...)
@@ -270,7 +366,6 @@ func (p *DelayProfile) read(ctx context.Context, diags *diag.Diagnostics) *whisp
 	profile.SetBypassIfHighestQuality(p.BypassIfHighestQuality.ValueBool())
 	profile.SetEnableTorrent(p.EnableTorrent.ValueBool())
 	profile.SetEnableUsenet(p.EnableUsenet.ValueBool())
-	profile.SetOrder(int32(p.Order.ValueInt64()))
 	profile.SetPreferredProtocol(whisparr.DownloadProtocol(p.PreferredProtocol.ValueString()))
 	diags.Append(p.Tags.ElementsAs(ctx, &profile.Tags, true)...)
 	profile.SetTorrentDelay(int32(p.TorrentDelay.ValueInt64()))
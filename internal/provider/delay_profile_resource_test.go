@@ -40,6 +40,14 @@ func TestAccDelayProfileResource(t *testing.T) {
 					resource.TestCheckResourceAttr("whisparr_delay_profile.test", "preferred_protocol", "torrent"),
 				),
 			},
+			// Tag labels testing
+			{
+				Config: testAccTagResourceConfig("test", "delay_profile_resource") + testAccDelayProfileResourceTagLabelsConfig("torrent", "whisparr_tag.test.label"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("whisparr_delay_profile.test", "tag_labels.0", "delay_profile_resource"),
+					resource.TestCheckResourceAttrPair("whisparr_delay_profile.test", "tags.0", "whisparr_tag.test", "id"),
+				),
+			},
 			// ImportState testing
 			{
 				ResourceName:      "whisparr_delay_profile.test",
@@ -51,16 +59,73 @@ func TestAccDelayProfileResource(t *testing.T) {
 	})
 }
 
+func TestAccDelayProfileResourceDefault(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Adopt and Read testing
+			{
+				Config: testAccDelayProfileResourceDefaultConfig("usenet"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("whisparr_delay_profile.test", "id", "1"),
+					resource.TestCheckResourceAttr("whisparr_delay_profile.test", "preferred_protocol", "usenet"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccDelayProfileResourceDefaultConfig("torrent"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("whisparr_delay_profile.test", "id", "1"),
+					resource.TestCheckResourceAttr("whisparr_delay_profile.test", "preferred_protocol", "torrent"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "whisparr_delay_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDelayProfileResourceDefaultConfig(protocol string) string {
+	return fmt.Sprintf(`
+	resource "whisparr_delay_profile" "test" {
+		default_profile = true
+		enable_usenet = true
+		enable_torrent = true
+		preferred_protocol= "%s"
+		tags = []
+	}`, protocol)
+}
+
 func testAccDelayProfileResourceConfig(protocol, tag string) string {
 	return fmt.Sprintf(`
 	resource "whisparr_delay_profile" "test" {
 		enable_usenet = true
 		enable_torrent = true
 		bypass_if_highest_quality = true
-		order = 100
 		usenet_delay = 0
 		torrent_delay = 0
 		preferred_protocol= "%s"
 		tags = [%s]
 	}`, protocol, tag)
 }
+
+func testAccDelayProfileResourceTagLabelsConfig(protocol, label string) string {
+	return fmt.Sprintf(`
+	resource "whisparr_delay_profile" "test" {
+		enable_usenet = true
+		enable_torrent = true
+		bypass_if_highest_quality = true
+		usenet_delay = 0
+		torrent_delay = 0
+		preferred_protocol= "%s"
+		tag_labels = [%s]
+	}`, protocol, label)
+}
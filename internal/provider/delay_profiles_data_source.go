@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,7 +22,7 @@ func NewDelayProfilesDataSource() datasource.DataSource {
 
 // DelayProfilesDataSource defines the delay profiles implementation.
 type DelayProfilesDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // DelayProfiles describes the delay profiles data model.
@@ -74,6 +73,10 @@ func (d *DelayProfilesDataSource) Schema(_ context.Context, _ datasource.SchemaR
 							MarkdownDescription: "Torrent Delay.",
 							Computed:            true,
 						},
+						"default_profile": schema.BoolAttribute{
+							MarkdownDescription: "Default profile flag.",
+							Computed:            true,
+						},
 						"order": schema.Int64Attribute{
 							MarkdownDescription: "Order.",
 							Computed:            true,
@@ -83,6 +86,11 @@ func (d *DelayProfilesDataSource) Schema(_ context.Context, _ datasource.SchemaR
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"tag_labels": schema.SetAttribute{
+							MarkdownDescription: "List of associated tag labels.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 						"preferred_protocol": schema.StringAttribute{
 							MarkdownDescription: "Preferred protocol.",
 							Computed:            true,
@@ -104,16 +112,19 @@ func (d *DelayProfilesDataSource) Read(ctx context.Context, _ datasource.ReadReq
 	// Get delayprofiles current value
 	response, _, err := d.client.DelayProfileApi.ListDelayProfile(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, delayProfileResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.List, delayProfileResourceName, err)...)
 
 		return
 	}
 
 	tflog.Trace(ctx, "read "+delayProfileResourceName)
+	labels := d.client.TagLabelMap(ctx, &resp.Diagnostics)
+
 	// Map response body to resource schema attribute
 	profiles := make([]DelayProfile, len(response))
 	for i, p := range response {
 		profiles[i].write(ctx, p, &resp.Diagnostics)
+		profiles[i].TagLabels = helpers.MapTagLabels(ctx, profiles[i].Tags, labels, &resp.Diagnostics)
 	}
 
 	profileList, diags := types.SetValueFrom(ctx, DelayProfile{}.getType(), profiles)
@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -25,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &DownloadClientAria2Resource{}
-	_ resource.ResourceWithImportState = &DownloadClientAria2Resource{}
+	_ resource.Resource                 = &DownloadClientAria2Resource{}
+	_ resource.ResourceWithImportState  = &DownloadClientAria2Resource{}
+	_ resource.ResourceWithMoveState    = &DownloadClientAria2Resource{}
+	_ resource.ResourceWithUpgradeState = &DownloadClientAria2Resource{}
 )
 
 func NewDownloadClientAria2Resource() resource.Resource {
@@ -35,12 +39,13 @@ func NewDownloadClientAria2Resource() resource.Resource {
 
 // DownloadClientAria2Resource defines the download client implementation.
 type DownloadClientAria2Resource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // DownloadClientAria2 describes the download client data model.
 type DownloadClientAria2 struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	RPCPath                  types.String `tfsdk:"rpc_path"`
@@ -57,6 +62,7 @@ type DownloadClientAria2 struct {
 func (d DownloadClientAria2) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		Host:                     d.Host,
 		RPCPath:                  d.RPCPath,
@@ -76,6 +82,7 @@ func (d DownloadClientAria2) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientAria2) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.Host = client.Host
 	d.RPCPath = client.RPCPath
@@ -96,6 +103,7 @@ func (r *DownloadClientAria2Resource) Metadata(_ context.Context, req resource.M
 func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client Aria2 resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/whisparr/settings#download-clients) and [Aria2](https://wiki.servarr.com/whisparr/supported#aria2).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -127,6 +135,15 @@ func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -164,6 +181,12 @@ func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.Schem
 	}
 }
 
+func (r *DownloadClientAria2Resource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *DownloadClientAria2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -180,12 +203,18 @@ func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClientAria2
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientAria2ResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, downloadClientAria2ResourceName, err)...)
 
 		return
 	}
@@ -193,6 +222,7 @@ func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.C
 	tflog.Trace(ctx, "created "+downloadClientAria2ResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -207,9 +237,11 @@ func (r *DownloadClientAria2Resource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get DownloadClientAria2 current value
-	response, _, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientAria2ResourceName, err))
+		if !helpers.RemoveNotFound(ctx, downloadClientAria2ResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, downloadClientAria2ResourceName, err)...)
+		}
 
 		return
 	}
@@ -217,6 +249,7 @@ func (r *DownloadClientAria2Resource) Read(ctx context.Context, req resource.Rea
 	tflog.Trace(ctx, "read "+downloadClientAria2ResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -230,12 +263,18 @@ func (r *DownloadClientAria2Resource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientAria2
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientAria2ResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, downloadClientAria2ResourceName, err)...)
 
 		return
 	}
@@ -243,6 +282,7 @@ func (r *DownloadClientAria2Resource) Update(ctx context.Context, req resource.U
 	tflog.Trace(ctx, "updated "+downloadClientAria2ResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -258,7 +298,7 @@ func (r *DownloadClientAria2Resource) Delete(ctx context.Context, req resource.D
 	// Delete DownloadClientAria2 current value
 	_, err := r.client.DownloadClientApi.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientAria2ResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, downloadClientAria2ResourceName, err)...)
 
 		return
 	}
@@ -272,6 +312,12 @@ func (r *DownloadClientAria2Resource) ImportState(ctx context.Context, req resou
 	tflog.Trace(ctx, "imported "+downloadClientAria2ResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientAria2Resource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewDownloadClientResource(), "implementation", downloadClientAria2Implementation),
+	}
+}
+
 func (d *DownloadClientAria2) write(ctx context.Context, downloadClient *whisparr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,7 +20,7 @@ func NewDownloadClientConfigDataSource() datasource.DataSource {
 
 // DownloadClientConfigDataSource defines the download client config implementation.
 type DownloadClientConfigDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *DownloadClientConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -67,7 +66,7 @@ func (d *DownloadClientConfigDataSource) Read(ctx context.Context, _ datasource.
 	// Get indexer config current value
 	response, _, err := d.client.DownloadClientConfigApi.GetDownloadClientConfig(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientConfigDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, downloadClientConfigDataSourceName, err)...)
 
 		return
 	}
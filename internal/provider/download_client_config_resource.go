@@ -29,7 +29,7 @@ func NewDownloadClientConfigResource() resource.Resource {
 
 // DownloadClientConfigResource defines the download client config implementation.
 type DownloadClientConfigResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // DownloadClientConfig describes the download client config data model.
@@ -99,7 +99,7 @@ func (r *DownloadClientConfigResource) Create(ctx context.Context, req resource.
 	// Create new DownloadClientConfig
 	response, _, err := r.client.DownloadClientConfigApi.UpdateDownloadClientConfig(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientConfigResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Create, downloadClientConfigResourceName, err)...)
 
 		return
 	}
@@ -123,7 +123,7 @@ func (r *DownloadClientConfigResource) Read(ctx context.Context, req resource.Re
 	// Get downloadClientConfig current value
 	response, _, err := r.client.DownloadClientConfigApi.GetDownloadClientConfig(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientConfigResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, downloadClientConfigResourceName, err)...)
 
 		return
 	}
@@ -150,7 +150,7 @@ func (r *DownloadClientConfigResource) Update(ctx context.Context, req resource.
 	// Update DownloadClientConfig
 	response, _, err := r.client.DownloadClientConfigApi.UpdateDownloadClientConfig(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientConfigResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Update, downloadClientConfigResourceName, err)...)
 
 		return
 	}
@@ -23,7 +23,7 @@ func NewDownloadClientDataSource() datasource.DataSource {
 
 // DownloadClientDataSource defines the download_client implementation.
 type DownloadClientDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *DownloadClientDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -72,6 +72,11 @@ func (d *DownloadClientDataSource) Schema(_ context.Context, _ datasource.Schema
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -258,12 +263,13 @@ func (d *DownloadClientDataSource) Read(ctx context.Context, req datasource.Read
 	// Get downloadClient current value
 	response, _, err := d.client.DownloadClientApi.ListDownloadClient(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, downloadClientDataSourceName, err)...)
 
 		return
 	}
 
 	data.find(ctx, data.Name.ValueString(), response, &resp.Diagnostics)
+	data.TagLabels = d.client.TagLabels(ctx, data.Tags, &resp.Diagnostics)
 	tflog.Trace(ctx, "read "+downloadClientDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -7,6 +7,7 @@ import (
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,8 +28,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &DownloadClientDelugeResource{}
-	_ resource.ResourceWithImportState = &DownloadClientDelugeResource{}
+	_ resource.Resource                 = &DownloadClientDelugeResource{}
+	_ resource.ResourceWithImportState  = &DownloadClientDelugeResource{}
+	_ resource.ResourceWithMoveState    = &DownloadClientDelugeResource{}
+	_ resource.ResourceWithUpgradeState = &DownloadClientDelugeResource{}
 )
 
 func NewDownloadClientDelugeResource() resource.Resource {
@@ -37,12 +40,13 @@ func NewDownloadClientDelugeResource() resource.Resource {
 
 // DownloadClientDelugeResource defines the download client implementation.
 type DownloadClientDelugeResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // DownloadClientDeluge describes the download client data model.
 type DownloadClientDeluge struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	URLBase                  types.String `tfsdk:"url_base"`
@@ -64,6 +68,7 @@ type DownloadClientDeluge struct {
 func (d DownloadClientDeluge) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		Host:                     d.Host,
 		URLBase:                  d.URLBase,
@@ -88,6 +93,7 @@ func (d DownloadClientDeluge) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientDeluge) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.Host = client.Host
 	d.URLBase = client.URLBase
@@ -113,6 +119,7 @@ func (r *DownloadClientDelugeResource) Metadata(_ context.Context, req resource.
 func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client Deluge resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/whisparr/settings#download-clients) and [Deluge](https://wiki.servarr.com/whisparr/supported#deluge).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -144,6 +151,15 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -213,6 +229,12 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 	}
 }
 
+func (r *DownloadClientDelugeResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *DownloadClientDelugeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -229,12 +251,18 @@ func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClientDeluge
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientDelugeResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, downloadClientDelugeResourceName, err)...)
 
 		return
 	}
@@ -242,6 +270,7 @@ func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.
 	tflog.Trace(ctx, "created "+downloadClientDelugeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -256,9 +285,11 @@ func (r *DownloadClientDelugeResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get DownloadClientDeluge current value
-	response, _, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientDelugeResourceName, err))
+		if !helpers.RemoveNotFound(ctx, downloadClientDelugeResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, downloadClientDelugeResourceName, err)...)
+		}
 
 		return
 	}
@@ -266,6 +297,7 @@ func (r *DownloadClientDelugeResource) Read(ctx context.Context, req resource.Re
 	tflog.Trace(ctx, "read "+downloadClientDelugeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -279,12 +311,18 @@ func (r *DownloadClientDelugeResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientDeluge
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientDelugeResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, downloadClientDelugeResourceName, err)...)
 
 		return
 	}
@@ -292,6 +330,7 @@ func (r *DownloadClientDelugeResource) Update(ctx context.Context, req resource.
 	tflog.Trace(ctx, "updated "+downloadClientDelugeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -307,7 +346,7 @@ func (r *DownloadClientDelugeResource) Delete(ctx context.Context, req resource.
 	// Delete DownloadClientDeluge current value
 	_, err := r.client.DownloadClientApi.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientDelugeResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, downloadClientDelugeResourceName, err)...)
 
 		return
 	}
@@ -321,6 +360,12 @@ func (r *DownloadClientDelugeResource) ImportState(ctx context.Context, req reso
 	tflog.Trace(ctx, "imported "+downloadClientDelugeResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientDelugeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewDownloadClientResource(), "implementation", downloadClientDelugeImplementation),
+	}
+}
+
 func (d *DownloadClientDeluge) write(ctx context.Context, downloadClient *whisparr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
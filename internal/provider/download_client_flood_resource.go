@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -25,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &DownloadClientFloodResource{}
-	_ resource.ResourceWithImportState = &DownloadClientFloodResource{}
+	_ resource.Resource                 = &DownloadClientFloodResource{}
+	_ resource.ResourceWithImportState  = &DownloadClientFloodResource{}
+	_ resource.ResourceWithMoveState    = &DownloadClientFloodResource{}
+	_ resource.ResourceWithUpgradeState = &DownloadClientFloodResource{}
 )
 
 func NewDownloadClientFloodResource() resource.Resource {
@@ -35,12 +39,13 @@ func NewDownloadClientFloodResource() resource.Resource {
 
 // DownloadClientFloodResource defines the download client implementation.
 type DownloadClientFloodResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // DownloadClientFlood describes the download client data model.
 type DownloadClientFlood struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
 	FieldTags                types.Set    `tfsdk:"field_tags"`
 	AdditionalTags           types.Set    `tfsdk:"additional_tags"`
 	PostImportTags           types.Set    `tfsdk:"post_import_tags"`
@@ -63,6 +68,7 @@ type DownloadClientFlood struct {
 func (d DownloadClientFlood) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		FieldTags:                d.FieldTags,
 		PostImportTags:           d.PostImportTags,
 		AdditionalTags:           d.AdditionalTags,
@@ -88,6 +94,7 @@ func (d DownloadClientFlood) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientFlood) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.FieldTags = client.FieldTags
 	d.AdditionalTags = client.AdditionalTags
 	d.PostImportTags = client.PostImportTags
@@ -114,6 +121,7 @@ func (r *DownloadClientFloodResource) Metadata(_ context.Context, req resource.M
 func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client Flood resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/whisparr/settings#download-clients) and [Flood](https://wiki.servarr.com/whisparr/supported#flood).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -145,6 +153,15 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -216,6 +233,12 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 	}
 }
 
+func (r *DownloadClientFloodResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *DownloadClientFloodResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -232,12 +255,18 @@ func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientFloodResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, downloadClientFloodResourceName, err)...)
 
 		return
 	}
@@ -245,6 +274,7 @@ func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.C
 	tflog.Trace(ctx, "created "+downloadClientFloodResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -259,9 +289,11 @@ func (r *DownloadClientFloodResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get DownloadClientFlood current value
-	response, _, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientFloodResourceName, err))
+		if !helpers.RemoveNotFound(ctx, downloadClientFloodResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, downloadClientFloodResourceName, err)...)
+		}
 
 		return
 	}
@@ -269,6 +301,7 @@ func (r *DownloadClientFloodResource) Read(ctx context.Context, req resource.Rea
 	tflog.Trace(ctx, "read "+downloadClientFloodResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -282,12 +315,18 @@ func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientFloodResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, downloadClientFloodResourceName, err)...)
 
 		return
 	}
@@ -295,6 +334,7 @@ func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.U
 	tflog.Trace(ctx, "updated "+downloadClientFloodResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -310,7 +350,7 @@ func (r *DownloadClientFloodResource) Delete(ctx context.Context, req resource.D
 	// Delete DownloadClientFlood current value
 	_, err := r.client.DownloadClientApi.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientFloodResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, downloadClientFloodResourceName, err)...)
 
 		return
 	}
@@ -324,6 +364,12 @@ func (r *DownloadClientFloodResource) ImportState(ctx context.Context, req resou
 	tflog.Trace(ctx, "imported "+downloadClientFloodResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientFloodResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewDownloadClientResource(), "implementation", downloadClientFloodImplementation),
+	}
+}
+
 func (d *DownloadClientFlood) write(ctx context.Context, downloadClient *whisparr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -25,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &DownloadClientHadoukenResource{}
-	_ resource.ResourceWithImportState = &DownloadClientHadoukenResource{}
+	_ resource.Resource                 = &DownloadClientHadoukenResource{}
+	_ resource.ResourceWithImportState  = &DownloadClientHadoukenResource{}
+	_ resource.ResourceWithMoveState    = &DownloadClientHadoukenResource{}
+	_ resource.ResourceWithUpgradeState = &DownloadClientHadoukenResource{}
 )
 
 func NewDownloadClientHadoukenResource() resource.Resource {
@@ -35,12 +39,13 @@ func NewDownloadClientHadoukenResource() resource.Resource {
 
 // DownloadClientHadoukenResource defines the download client implementation.
 type DownloadClientHadoukenResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // DownloadClientHadouken describes the download client data model.
 type DownloadClientHadouken struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	URLBase                  types.String `tfsdk:"url_base"`
@@ -59,6 +64,7 @@ type DownloadClientHadouken struct {
 func (d DownloadClientHadouken) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		Host:                     d.Host,
 		URLBase:                  d.URLBase,
@@ -80,6 +86,7 @@ func (d DownloadClientHadouken) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientHadouken) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.Host = client.Host
 	d.URLBase = client.URLBase
@@ -102,6 +109,7 @@ func (r *DownloadClientHadoukenResource) Metadata(_ context.Context, req resourc
 func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client Hadouken resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/whisparr/settings#download-clients) and [Hadouken](https://wiki.servarr.com/whisparr/supported#hadouken).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -133,6 +141,15 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -179,6 +196,12 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 	}
 }
 
+func (r *DownloadClientHadoukenResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *DownloadClientHadoukenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -195,12 +218,18 @@ func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientHadoukenResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, downloadClientHadoukenResourceName, err)...)
 
 		return
 	}
@@ -208,6 +237,7 @@ func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resourc
 	tflog.Trace(ctx, "created "+downloadClientHadoukenResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -222,9 +252,11 @@ func (r *DownloadClientHadoukenResource) Read(ctx context.Context, req resource.
 	}
 
 	// Get DownloadClientHadouken current value
-	response, _, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientHadoukenResourceName, err))
+		if !helpers.RemoveNotFound(ctx, downloadClientHadoukenResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, downloadClientHadoukenResourceName, err)...)
+		}
 
 		return
 	}
@@ -232,6 +264,7 @@ func (r *DownloadClientHadoukenResource) Read(ctx context.Context, req resource.
 	tflog.Trace(ctx, "read "+downloadClientHadoukenResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -245,12 +278,18 @@ func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientHadoukenResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, downloadClientHadoukenResourceName, err)...)
 
 		return
 	}
@@ -258,6 +297,7 @@ func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resourc
 	tflog.Trace(ctx, "updated "+downloadClientHadoukenResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -273,7 +313,7 @@ func (r *DownloadClientHadoukenResource) Delete(ctx context.Context, req resourc
 	// Delete DownloadClientHadouken current value
 	_, err := r.client.DownloadClientApi.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientHadoukenResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, downloadClientHadoukenResourceName, err)...)
 
 		return
 	}
@@ -287,6 +327,12 @@ func (r *DownloadClientHadoukenResource) ImportState(ctx context.Context, req re
 	tflog.Trace(ctx, "imported "+downloadClientHadoukenResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientHadoukenResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewDownloadClientResource(), "implementation", downloadClientHadoukenImplementation),
+	}
+}
+
 func (d *DownloadClientHadouken) write(ctx context.Context, downloadClient *whisparr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
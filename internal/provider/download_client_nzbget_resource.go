@@ -7,6 +7,7 @@ import (
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,8 +28,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &DownloadClientNzbgetResource{}
-	_ resource.ResourceWithImportState = &DownloadClientNzbgetResource{}
+	_ resource.Resource                 = &DownloadClientNzbgetResource{}
+	_ resource.ResourceWithImportState  = &DownloadClientNzbgetResource{}
+	_ resource.ResourceWithMoveState    = &DownloadClientNzbgetResource{}
+	_ resource.ResourceWithUpgradeState = &DownloadClientNzbgetResource{}
 )
 
 func NewDownloadClientNzbgetResource() resource.Resource {
@@ -37,12 +40,13 @@ func NewDownloadClientNzbgetResource() resource.Resource {
 
 // DownloadClientNzbgetResource defines the download client implementation.
 type DownloadClientNzbgetResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // DownloadClientNzbget describes the download client data model.
 type DownloadClientNzbget struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	URLBase                  types.String `tfsdk:"url_base"`
@@ -64,6 +68,7 @@ type DownloadClientNzbget struct {
 func (d DownloadClientNzbget) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		Host:                     d.Host,
 		URLBase:                  d.URLBase,
@@ -88,6 +93,7 @@ func (d DownloadClientNzbget) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientNzbget) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.Host = client.Host
 	d.URLBase = client.URLBase
@@ -113,6 +119,7 @@ func (r *DownloadClientNzbgetResource) Metadata(_ context.Context, req resource.
 func (r *DownloadClientNzbgetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client NZBGet resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/whisparr/settings#download-clients) and [NZBGet](https://wiki.servarr.com/whisparr/supported#nzbget).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -144,6 +151,15 @@ func (r *DownloadClientNzbgetResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -213,6 +229,12 @@ func (r *DownloadClientNzbgetResource) Schema(_ context.Context, _ resource.Sche
 	}
 }
 
+func (r *DownloadClientNzbgetResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *DownloadClientNzbgetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -229,12 +251,18 @@ func (r *DownloadClientNzbgetResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClientNzbget
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientNzbgetResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, downloadClientNzbgetResourceName, err)...)
 
 		return
 	}
@@ -242,6 +270,7 @@ func (r *DownloadClientNzbgetResource) Create(ctx context.Context, req resource.
 	tflog.Trace(ctx, "created "+downloadClientNzbgetResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -256,9 +285,11 @@ func (r *DownloadClientNzbgetResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get DownloadClientNzbget current value
-	response, _, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientNzbgetResourceName, err))
+		if !helpers.RemoveNotFound(ctx, downloadClientNzbgetResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, downloadClientNzbgetResourceName, err)...)
+		}
 
 		return
 	}
@@ -266,6 +297,7 @@ func (r *DownloadClientNzbgetResource) Read(ctx context.Context, req resource.Re
 	tflog.Trace(ctx, "read "+downloadClientNzbgetResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -279,12 +311,18 @@ func (r *DownloadClientNzbgetResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientNzbget
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientNzbgetResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, downloadClientNzbgetResourceName, err)...)
 
 		return
 	}
@@ -292,6 +330,7 @@ func (r *DownloadClientNzbgetResource) Update(ctx context.Context, req resource.
 	tflog.Trace(ctx, "updated "+downloadClientNzbgetResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -307,7 +346,7 @@ func (r *DownloadClientNzbgetResource) Delete(ctx context.Context, req resource.
 	// Delete DownloadClientNzbget current value
 	_, err := r.client.DownloadClientApi.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientNzbgetResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, downloadClientNzbgetResourceName, err)...)
 
 		return
 	}
@@ -321,6 +360,12 @@ func (r *DownloadClientNzbgetResource) ImportState(ctx context.Context, req reso
 	tflog.Trace(ctx, "imported "+downloadClientNzbgetResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientNzbgetResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewDownloadClientResource(), "implementation", downloadClientNzbgetImplementation),
+	}
+}
+
 func (d *DownloadClientNzbget) write(ctx context.Context, downloadClient *whisparr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
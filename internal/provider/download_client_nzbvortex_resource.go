@@ -7,6 +7,7 @@ import (
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,8 +28,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &DownloadClientNzbvortexResource{}
-	_ resource.ResourceWithImportState = &DownloadClientNzbvortexResource{}
+	_ resource.Resource                 = &DownloadClientNzbvortexResource{}
+	_ resource.ResourceWithImportState  = &DownloadClientNzbvortexResource{}
+	_ resource.ResourceWithMoveState    = &DownloadClientNzbvortexResource{}
+	_ resource.ResourceWithUpgradeState = &DownloadClientNzbvortexResource{}
 )
 
 func NewDownloadClientNzbvortexResource() resource.Resource {
@@ -37,12 +40,13 @@ func NewDownloadClientNzbvortexResource() resource.Resource {
 
 // DownloadClientNzbvortexResource defines the download client implementation.
 type DownloadClientNzbvortexResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // DownloadClientNzbvortex describes the download client data model.
 type DownloadClientNzbvortex struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	URLBase                  types.String `tfsdk:"url_base"`
@@ -60,6 +64,7 @@ type DownloadClientNzbvortex struct {
 func (d DownloadClientNzbvortex) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		Host:                     d.Host,
 		URLBase:                  d.URLBase,
@@ -80,6 +85,7 @@ func (d DownloadClientNzbvortex) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientNzbvortex) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.Host = client.Host
 	d.URLBase = client.URLBase
@@ -101,6 +107,7 @@ func (r *DownloadClientNzbvortexResource) Metadata(_ context.Context, req resour
 func (r *DownloadClientNzbvortexResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client Nzbvortex resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/whisparr/settings#download-clients) and [Nzbvortex](https://wiki.servarr.com/whisparr/supported#nzbvortex).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -132,6 +139,15 @@ func (r *DownloadClientNzbvortexResource) Schema(_ context.Context, _ resource.S
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -179,6 +195,12 @@ func (r *DownloadClientNzbvortexResource) Schema(_ context.Context, _ resource.S
 	}
 }
 
+func (r *DownloadClientNzbvortexResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *DownloadClientNzbvortexResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -195,12 +217,18 @@ func (r *DownloadClientNzbvortexResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClientNzbvortex
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientNzbvortexResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, downloadClientNzbvortexResourceName, err)...)
 
 		return
 	}
@@ -208,6 +236,7 @@ func (r *DownloadClientNzbvortexResource) Create(ctx context.Context, req resour
 	tflog.Trace(ctx, "created "+downloadClientNzbvortexResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -222,9 +251,11 @@ func (r *DownloadClientNzbvortexResource) Read(ctx context.Context, req resource
 	}
 
 	// Get DownloadClientNzbvortex current value
-	response, _, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientNzbvortexResourceName, err))
+		if !helpers.RemoveNotFound(ctx, downloadClientNzbvortexResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, downloadClientNzbvortexResourceName, err)...)
+		}
 
 		return
 	}
@@ -232,6 +263,7 @@ func (r *DownloadClientNzbvortexResource) Read(ctx context.Context, req resource
 	tflog.Trace(ctx, "read "+downloadClientNzbvortexResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -245,12 +277,18 @@ func (r *DownloadClientNzbvortexResource) Update(ctx context.Context, req resour
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientNzbvortex
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientNzbvortexResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, downloadClientNzbvortexResourceName, err)...)
 
 		return
 	}
@@ -258,6 +296,7 @@ func (r *DownloadClientNzbvortexResource) Update(ctx context.Context, req resour
 	tflog.Trace(ctx, "updated "+downloadClientNzbvortexResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -273,7 +312,7 @@ func (r *DownloadClientNzbvortexResource) Delete(ctx context.Context, req resour
 	// Delete DownloadClientNzbvortex current value
 	_, err := r.client.DownloadClientApi.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientNzbvortexResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, downloadClientNzbvortexResourceName, err)...)
 
 		return
 	}
@@ -287,6 +326,12 @@ func (r *DownloadClientNzbvortexResource) ImportState(ctx context.Context, req r
 	tflog.Trace(ctx, "imported "+downloadClientNzbvortexResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientNzbvortexResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewDownloadClientResource(), "implementation", downloadClientNzbvortexImplementation),
+	}
+}
+
 func (d *DownloadClientNzbvortex) write(ctx context.Context, downloadClient *whisparr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -25,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &DownloadClientPneumaticResource{}
-	_ resource.ResourceWithImportState = &DownloadClientPneumaticResource{}
+	_ resource.Resource                 = &DownloadClientPneumaticResource{}
+	_ resource.ResourceWithImportState  = &DownloadClientPneumaticResource{}
+	_ resource.ResourceWithMoveState    = &DownloadClientPneumaticResource{}
+	_ resource.ResourceWithUpgradeState = &DownloadClientPneumaticResource{}
 )
 
 func NewDownloadClientPneumaticResource() resource.Resource {
@@ -35,12 +39,13 @@ func NewDownloadClientPneumaticResource() resource.Resource {
 
 // DownloadClientPneumaticResource defines the download client implementation.
 type DownloadClientPneumaticResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // DownloadClientPneumatic describes the download client data model.
 type DownloadClientPneumatic struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
 	Name                     types.String `tfsdk:"name"`
 	NzbFolder                types.String `tfsdk:"nzb_folder"`
 	StrmFolder               types.String `tfsdk:"strm_folder"`
@@ -54,6 +59,7 @@ type DownloadClientPneumatic struct {
 func (d DownloadClientPneumatic) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		NzbFolder:                d.NzbFolder,
 		StrmFolder:               d.StrmFolder,
@@ -70,6 +76,7 @@ func (d DownloadClientPneumatic) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientPneumatic) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.NzbFolder = client.NzbFolder
 	d.StrmFolder = client.StrmFolder
@@ -87,6 +94,7 @@ func (r *DownloadClientPneumaticResource) Metadata(_ context.Context, req resour
 func (r *DownloadClientPneumaticResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client Pneumatic resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/whisparr/settings#download-clients) and [Pneumatic](https://wiki.servarr.com/whisparr/supported#pneumatic).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -118,6 +126,15 @@ func (r *DownloadClientPneumaticResource) Schema(_ context.Context, _ resource.S
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -138,6 +155,12 @@ func (r *DownloadClientPneumaticResource) Schema(_ context.Context, _ resource.S
 	}
 }
 
+func (r *DownloadClientPneumaticResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *DownloadClientPneumaticResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -154,12 +177,18 @@ func (r *DownloadClientPneumaticResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClientPneumatic
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientPneumaticResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, downloadClientPneumaticResourceName, err)...)
 
 		return
 	}
@@ -167,6 +196,7 @@ func (r *DownloadClientPneumaticResource) Create(ctx context.Context, req resour
 	tflog.Trace(ctx, "created "+downloadClientPneumaticResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -181,9 +211,11 @@ func (r *DownloadClientPneumaticResource) Read(ctx context.Context, req resource
 	}
 
 	// Get DownloadClientPneumatic current value
-	response, _, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientPneumaticResourceName, err))
+		if !helpers.RemoveNotFound(ctx, downloadClientPneumaticResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, downloadClientPneumaticResourceName, err)...)
+		}
 
 		return
 	}
@@ -191,6 +223,7 @@ func (r *DownloadClientPneumaticResource) Read(ctx context.Context, req resource
 	tflog.Trace(ctx, "read "+downloadClientPneumaticResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -204,12 +237,18 @@ func (r *DownloadClientPneumaticResource) Update(ctx context.Context, req resour
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientPneumatic
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientPneumaticResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, downloadClientPneumaticResourceName, err)...)
 
 		return
 	}
@@ -217,6 +256,7 @@ func (r *DownloadClientPneumaticResource) Update(ctx context.Context, req resour
 	tflog.Trace(ctx, "updated "+downloadClientPneumaticResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -232,7 +272,7 @@ func (r *DownloadClientPneumaticResource) Delete(ctx context.Context, req resour
 	// Delete DownloadClientPneumatic current value
 	_, err := r.client.DownloadClientApi.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientPneumaticResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, downloadClientPneumaticResourceName, err)...)
 
 		return
 	}
@@ -246,6 +286,12 @@ func (r *DownloadClientPneumaticResource) ImportState(ctx context.Context, req r
 	tflog.Trace(ctx, "imported "+downloadClientPneumaticResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientPneumaticResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewDownloadClientResource(), "implementation", downloadClientPneumaticImplementation),
+	}
+}
+
 func (d *DownloadClientPneumatic) write(ctx context.Context, downloadClient *whisparr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
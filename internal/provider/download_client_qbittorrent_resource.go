@@ -7,6 +7,7 @@ import (
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -29,8 +30,10 @@ var downloadClientQbittorrentInitialStates = []int64{0, 1, 2}
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &DownloadClientQbittorrentResource{}
-	_ resource.ResourceWithImportState = &DownloadClientQbittorrentResource{}
+	_ resource.Resource                 = &DownloadClientQbittorrentResource{}
+	_ resource.ResourceWithImportState  = &DownloadClientQbittorrentResource{}
+	_ resource.ResourceWithMoveState    = &DownloadClientQbittorrentResource{}
+	_ resource.ResourceWithUpgradeState = &DownloadClientQbittorrentResource{}
 )
 
 func NewDownloadClientQbittorrentResource() resource.Resource {
@@ -39,12 +42,13 @@ func NewDownloadClientQbittorrentResource() resource.Resource {
 
 // DownloadClientQbittorrentResource defines the download client implementation.
 type DownloadClientQbittorrentResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // DownloadClientQbittorrent describes the download client data model.
 type DownloadClientQbittorrent struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
 	MovieImportedCategory    types.String `tfsdk:"movie_imported_category"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
@@ -69,6 +73,7 @@ type DownloadClientQbittorrent struct {
 func (d DownloadClientQbittorrent) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		Host:                     d.Host,
 		URLBase:                  d.URLBase,
@@ -96,6 +101,7 @@ func (d DownloadClientQbittorrent) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientQbittorrent) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.Host = client.Host
 	d.URLBase = client.URLBase
@@ -124,6 +130,7 @@ func (r *DownloadClientQbittorrentResource) Metadata(_ context.Context, req reso
 func (r *DownloadClientQbittorrentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client qBittorrent resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/whisparr/settings#download-clients) and [qBittorrent](https://wiki.servarr.com/whisparr/supported#qbittorrent).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -155,6 +162,15 @@ func (r *DownloadClientQbittorrentResource) Schema(_ context.Context, _ resource
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -242,6 +258,12 @@ func (r *DownloadClientQbittorrentResource) Schema(_ context.Context, _ resource
 	}
 }
 
+func (r *DownloadClientQbittorrentResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *DownloadClientQbittorrentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -258,12 +280,18 @@ func (r *DownloadClientQbittorrentResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClientQbittorrent
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientQbittorrentResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, downloadClientQbittorrentResourceName, err)...)
 
 		return
 	}
@@ -271,6 +299,7 @@ func (r *DownloadClientQbittorrentResource) Create(ctx context.Context, req reso
 	tflog.Trace(ctx, "created "+downloadClientQbittorrentResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -285,9 +314,11 @@ func (r *DownloadClientQbittorrentResource) Read(ctx context.Context, req resour
 	}
 
 	// Get DownloadClientQbittorrent current value
-	response, _, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientQbittorrentResourceName, err))
+		if !helpers.RemoveNotFound(ctx, downloadClientQbittorrentResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, downloadClientQbittorrentResourceName, err)...)
+		}
 
 		return
 	}
@@ -295,6 +326,7 @@ func (r *DownloadClientQbittorrentResource) Read(ctx context.Context, req resour
 	tflog.Trace(ctx, "read "+downloadClientQbittorrentResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -308,12 +340,18 @@ func (r *DownloadClientQbittorrentResource) Update(ctx context.Context, req reso
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientQbittorrent
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientQbittorrentResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, downloadClientQbittorrentResourceName, err)...)
 
 		return
 	}
@@ -321,6 +359,7 @@ func (r *DownloadClientQbittorrentResource) Update(ctx context.Context, req reso
 	tflog.Trace(ctx, "updated "+downloadClientQbittorrentResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -336,7 +375,7 @@ func (r *DownloadClientQbittorrentResource) Delete(ctx context.Context, req reso
 	// Delete DownloadClientQbittorrent current value
 	_, err := r.client.DownloadClientApi.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientQbittorrentResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, downloadClientQbittorrentResourceName, err)...)
 
 		return
 	}
@@ -350,6 +389,12 @@ func (r *DownloadClientQbittorrentResource) ImportState(ctx context.Context, req
 	tflog.Trace(ctx, "imported "+downloadClientQbittorrentResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientQbittorrentResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewDownloadClientResource(), "implementation", downloadClientQbittorrentImplementation),
+	}
+}
+
 func (d *DownloadClientQbittorrent) write(ctx context.Context, downloadClient *whisparr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
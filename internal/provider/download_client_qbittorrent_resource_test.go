@@ -8,6 +8,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
+func testAccDownloadClientQbittorrentResourceTagLabelsConfig(name, label string) string {
+	return fmt.Sprintf(`
+	resource "whisparr_download_client_qbittorrent" "test" {
+		enable = false
+		priority = 1
+		name = "%s"
+		host = "qbittorrent"
+		url_base = "/qbittorrent/"
+		port = 9091
+		movie_category = "tv-whisparr"
+		first_and_last = true
+		tag_labels = [%s]
+	}`, name, label)
+}
+
 func TestAccDownloadClientQbittorrentResource(t *testing.T) {
 	t.Parallel()
 
@@ -41,6 +56,14 @@ func TestAccDownloadClientQbittorrentResource(t *testing.T) {
 					resource.TestCheckResourceAttr("whisparr_download_client_qbittorrent.test", "host", "qbittorrent-host"),
 				),
 			},
+			// Tag labels testing
+			{
+				Config: testAccTagResourceConfig("test", "qbittorrent") + testAccDownloadClientQbittorrentResourceTagLabelsConfig("resourceQbittorrentTest", "whisparr_tag.test.label"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("whisparr_download_client_qbittorrent.test", "tag_labels.0", "qbittorrent"),
+					resource.TestCheckResourceAttrPair("whisparr_download_client_qbittorrent.test", "tags.0", "whisparr_tag.test", "id"),
+				),
+			},
 			// ImportState testing
 			{
 				ResourceName:      "whisparr_download_client_qbittorrent.test",
@@ -7,6 +7,7 @@ import (
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -24,8 +25,10 @@ const downloadClientResourceName = "download_client"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &DownloadClientResource{}
-	_ resource.ResourceWithImportState = &DownloadClientResource{}
+	_ resource.Resource                     = &DownloadClientResource{}
+	_ resource.ResourceWithImportState      = &DownloadClientResource{}
+	_ resource.ResourceWithConfigValidators = &DownloadClientResource{}
+	_ resource.ResourceWithUpgradeState     = &DownloadClientResource{}
 )
 
 var downloadClientFields = helpers.Fields{
@@ -43,12 +46,13 @@ func NewDownloadClientResource() resource.Resource {
 
 // DownloadClientResource defines the download client implementation.
 type DownloadClientResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // DownloadClient describes the download client data model.
 type DownloadClient struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
 	PostImportTags           types.Set    `tfsdk:"post_import_tags"`
 	FieldTags                types.Set    `tfsdk:"field_tags"`
 	AdditionalTags           types.Set    `tfsdk:"additional_tags"`
@@ -103,6 +107,7 @@ func (d DownloadClient) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
 			"tags":                       types.SetType{}.WithElementType(types.Int64Type),
+			"tag_labels":                 types.SetType{}.WithElementType(types.StringType),
 			"additional_tags":            types.SetType{}.WithElementType(types.Int64Type),
 			"post_import_tags":           types.SetType{}.WithElementType(types.StringType),
 			"field_tags":                 types.SetType{}.WithElementType(types.StringType),
@@ -160,7 +165,8 @@ func (r *DownloadClientResource) Metadata(_ context.Context, req resource.Metada
 
 func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "<!-- subcategory:Download Clients -->Generic Download Client resource. When possible use a specific resource instead.\nFor more information refer to [Download Client](https://wiki.servarr.com/whisparr/settings#download-clients).",
+		MarkdownDescription: "<!-- subcategory:Download Clients -->Generic Download Client resource. When possible use a specific resource instead, existing ones can be moved there with a `moved` block (Terraform 1.8+).\nFor more information refer to [Download Client](https://wiki.servarr.com/whisparr/settings#download-clients).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -207,6 +213,15 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -434,12 +449,47 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 	}
 }
 
+func (r *DownloadClientResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *DownloadClientResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 	}
 }
 
+func (r *DownloadClientResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		helpers.ImplementationValidator{
+			Resources: map[string]func() resource.Resource{
+				downloadClientAria2Implementation:                  NewDownloadClientAria2Resource,
+				downloadClientDelugeImplementation:                 NewDownloadClientDelugeResource,
+				downloadClientFloodImplementation:                  NewDownloadClientFloodResource,
+				downloadClientHadoukenImplementation:               NewDownloadClientHadoukenResource,
+				downloadClientNzbgetImplementation:                 NewDownloadClientNzbgetResource,
+				downloadClientNzbvortexImplementation:              NewDownloadClientNzbvortexResource,
+				downloadClientPneumaticImplementation:              NewDownloadClientPneumaticResource,
+				downloadClientQbittorrentImplementation:            NewDownloadClientQbittorrentResource,
+				downloadClientRtorrentImplementation:               NewDownloadClientRtorrentResource,
+				downloadClientSabnzbdImplementation:                NewDownloadClientSabnzbdResource,
+				downloadClientTorrentBlackholeImplementation:       NewDownloadClientTorrentBlackholeResource,
+				downloadClientTorrentDownloadStationImplementation: NewDownloadClientTorrentDownloadStationResource,
+				downloadClientTransmissionImplementation:           NewDownloadClientTransmissionResource,
+				downloadClientUsenetBlackholeImplementation:        NewDownloadClientUsenetBlackholeResource,
+				downloadClientUsenetDownloadStationImplementation:  NewDownloadClientUsenetDownloadStationResource,
+				downloadClientUtorrentImplementation:               NewDownloadClientUtorrentResource,
+				downloadClientVuzeImplementation:                   NewDownloadClientVuzeResource,
+			},
+			Attribute: "implementation",
+			Model:     &DownloadClient{},
+			Fields:    downloadClientFields,
+		},
+	}
+}
+
 func (r *DownloadClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClient
@@ -450,12 +500,18 @@ func (r *DownloadClientResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClient
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, downloadClientResourceName, err)...)
 
 		return
 	}
@@ -466,6 +522,7 @@ func (r *DownloadClientResource) Create(ctx context.Context, req resource.Create
 	var state DownloadClient
 
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = r.client.TagLabels(ctx, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -480,9 +537,11 @@ func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get DownloadClient current value
-	response, _, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientResourceName, err))
+		if !helpers.RemoveNotFound(ctx, downloadClientResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, downloadClientResourceName, err)...)
+		}
 
 		return
 	}
@@ -493,6 +552,7 @@ func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequ
 	var state DownloadClient
 
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = r.client.TagLabels(ctx, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -506,12 +566,18 @@ func (r *DownloadClientResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClient
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, downloadClientResourceName, err)...)
 
 		return
 	}
@@ -522,6 +588,7 @@ func (r *DownloadClientResource) Update(ctx context.Context, req resource.Update
 	var state DownloadClient
 
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = r.client.TagLabels(ctx, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -537,7 +604,7 @@ func (r *DownloadClientResource) Delete(ctx context.Context, req resource.Delete
 	// Delete DownloadClient current value
 	_, err := r.client.DownloadClientApi.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, downloadClientResourceName, err)...)
 
 		return
 	}
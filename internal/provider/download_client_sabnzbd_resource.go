@@ -7,6 +7,7 @@ import (
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,8 +28,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &DownloadClientSabnzbdResource{}
-	_ resource.ResourceWithImportState = &DownloadClientSabnzbdResource{}
+	_ resource.Resource                 = &DownloadClientSabnzbdResource{}
+	_ resource.ResourceWithImportState  = &DownloadClientSabnzbdResource{}
+	_ resource.ResourceWithMoveState    = &DownloadClientSabnzbdResource{}
+	_ resource.ResourceWithUpgradeState = &DownloadClientSabnzbdResource{}
 )
 
 func NewDownloadClientSabnzbdResource() resource.Resource {
@@ -37,12 +40,13 @@ func NewDownloadClientSabnzbdResource() resource.Resource {
 
 // DownloadClientSabnzbdResource defines the download client implementation.
 type DownloadClientSabnzbdResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // DownloadClientSabnzbd describes the download client data model.
 type DownloadClientSabnzbd struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
 	URLBase                  types.String `tfsdk:"url_base"`
@@ -64,6 +68,7 @@ type DownloadClientSabnzbd struct {
 func (d DownloadClientSabnzbd) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		Host:                     d.Host,
 		URLBase:                  d.URLBase,
@@ -88,6 +93,7 @@ func (d DownloadClientSabnzbd) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientSabnzbd) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.Host = client.Host
 	d.URLBase = client.URLBase
@@ -113,6 +119,7 @@ func (r *DownloadClientSabnzbdResource) Metadata(_ context.Context, req resource
 func (r *DownloadClientSabnzbdResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client Sabnzbd resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/whisparr/settings#download-clients) and [Sabnzbd](https://wiki.servarr.com/whisparr/supported#sabnzbd).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -144,6 +151,15 @@ func (r *DownloadClientSabnzbdResource) Schema(_ context.Context, _ resource.Sch
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -214,6 +230,12 @@ func (r *DownloadClientSabnzbdResource) Schema(_ context.Context, _ resource.Sch
 	}
 }
 
+func (r *DownloadClientSabnzbdResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *DownloadClientSabnzbdResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -230,12 +252,18 @@ func (r *DownloadClientSabnzbdResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClientSabnzbd
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientSabnzbdResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, downloadClientSabnzbdResourceName, err)...)
 
 		return
 	}
@@ -243,6 +271,7 @@ func (r *DownloadClientSabnzbdResource) Create(ctx context.Context, req resource
 	tflog.Trace(ctx, "created "+downloadClientSabnzbdResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -257,9 +286,11 @@ func (r *DownloadClientSabnzbdResource) Read(ctx context.Context, req resource.R
 	}
 
 	// Get DownloadClientSabnzbd current value
-	response, _, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientSabnzbdResourceName, err))
+		if !helpers.RemoveNotFound(ctx, downloadClientSabnzbdResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, downloadClientSabnzbdResourceName, err)...)
+		}
 
 		return
 	}
@@ -267,6 +298,7 @@ func (r *DownloadClientSabnzbdResource) Read(ctx context.Context, req resource.R
 	tflog.Trace(ctx, "read "+downloadClientSabnzbdResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -280,12 +312,18 @@ func (r *DownloadClientSabnzbdResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientSabnzbd
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientSabnzbdResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, downloadClientSabnzbdResourceName, err)...)
 
 		return
 	}
@@ -293,6 +331,7 @@ func (r *DownloadClientSabnzbdResource) Update(ctx context.Context, req resource
 	tflog.Trace(ctx, "updated "+downloadClientSabnzbdResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -308,7 +347,7 @@ func (r *DownloadClientSabnzbdResource) Delete(ctx context.Context, req resource
 	// Delete DownloadClientSabnzbd current value
 	_, err := r.client.DownloadClientApi.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientSabnzbdResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, downloadClientSabnzbdResourceName, err)...)
 
 		return
 	}
@@ -322,6 +361,12 @@ func (r *DownloadClientSabnzbdResource) ImportState(ctx context.Context, req res
 	tflog.Trace(ctx, "imported "+downloadClientSabnzbdResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientSabnzbdResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewDownloadClientResource(), "implementation", downloadClientSabnzbdImplementation),
+	}
+}
+
 func (d *DownloadClientSabnzbd) write(ctx context.Context, downloadClient *whisparr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
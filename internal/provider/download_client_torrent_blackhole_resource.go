@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -25,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &DownloadClientTorrentBlackholeResource{}
-	_ resource.ResourceWithImportState = &DownloadClientTorrentBlackholeResource{}
+	_ resource.Resource                 = &DownloadClientTorrentBlackholeResource{}
+	_ resource.ResourceWithImportState  = &DownloadClientTorrentBlackholeResource{}
+	_ resource.ResourceWithMoveState    = &DownloadClientTorrentBlackholeResource{}
+	_ resource.ResourceWithUpgradeState = &DownloadClientTorrentBlackholeResource{}
 )
 
 func NewDownloadClientTorrentBlackholeResource() resource.Resource {
@@ -35,12 +39,13 @@ func NewDownloadClientTorrentBlackholeResource() resource.Resource {
 
 // DownloadClientTorrentBlackholeResource defines the download client implementation.
 type DownloadClientTorrentBlackholeResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // DownloadClientTorrentBlackhole describes the download client data model.
 type DownloadClientTorrentBlackhole struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
 	Name                     types.String `tfsdk:"name"`
 	TorrentFolder            types.String `tfsdk:"torrent_folder"`
 	WatchFolder              types.String `tfsdk:"watch_folder"`
@@ -57,6 +62,7 @@ type DownloadClientTorrentBlackhole struct {
 func (d DownloadClientTorrentBlackhole) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		TorrentFolder:            d.TorrentFolder,
 		WatchFolder:              d.WatchFolder,
@@ -76,6 +82,7 @@ func (d DownloadClientTorrentBlackhole) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientTorrentBlackhole) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.TorrentFolder = client.TorrentFolder
 	d.WatchFolder = client.WatchFolder
@@ -96,6 +103,7 @@ func (r *DownloadClientTorrentBlackholeResource) Metadata(_ context.Context, req
 func (r *DownloadClientTorrentBlackholeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client Torrent Blackhole resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/whisparr/settings#download-clients) and [TorrentBlackhole](https://wiki.servarr.com/whisparr/supported#torrentblackhole).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -127,6 +135,15 @@ func (r *DownloadClientTorrentBlackholeResource) Schema(_ context.Context, _ res
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -162,6 +179,12 @@ func (r *DownloadClientTorrentBlackholeResource) Schema(_ context.Context, _ res
 	}
 }
 
+func (r *DownloadClientTorrentBlackholeResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *DownloadClientTorrentBlackholeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -178,12 +201,18 @@ func (r *DownloadClientTorrentBlackholeResource) Create(ctx context.Context, req
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClientTorrentBlackhole
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientTorrentBlackholeResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, downloadClientTorrentBlackholeResourceName, err)...)
 
 		return
 	}
@@ -191,6 +220,7 @@ func (r *DownloadClientTorrentBlackholeResource) Create(ctx context.Context, req
 	tflog.Trace(ctx, "created "+downloadClientTorrentBlackholeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -205,9 +235,11 @@ func (r *DownloadClientTorrentBlackholeResource) Read(ctx context.Context, req r
 	}
 
 	// Get DownloadClientTorrentBlackhole current value
-	response, _, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientTorrentBlackholeResourceName, err))
+		if !helpers.RemoveNotFound(ctx, downloadClientTorrentBlackholeResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, downloadClientTorrentBlackholeResourceName, err)...)
+		}
 
 		return
 	}
@@ -215,6 +247,7 @@ func (r *DownloadClientTorrentBlackholeResource) Read(ctx context.Context, req r
 	tflog.Trace(ctx, "read "+downloadClientTorrentBlackholeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -228,12 +261,18 @@ func (r *DownloadClientTorrentBlackholeResource) Update(ctx context.Context, req
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientTorrentBlackhole
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientTorrentBlackholeResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, downloadClientTorrentBlackholeResourceName, err)...)
 
 		return
 	}
@@ -241,6 +280,7 @@ func (r *DownloadClientTorrentBlackholeResource) Update(ctx context.Context, req
 	tflog.Trace(ctx, "updated "+downloadClientTorrentBlackholeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -256,7 +296,7 @@ func (r *DownloadClientTorrentBlackholeResource) Delete(ctx context.Context, req
 	// Delete DownloadClientTorrentBlackhole current value
 	_, err := r.client.DownloadClientApi.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientTorrentBlackholeResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, downloadClientTorrentBlackholeResourceName, err)...)
 
 		return
 	}
@@ -270,6 +310,12 @@ func (r *DownloadClientTorrentBlackholeResource) ImportState(ctx context.Context
 	tflog.Trace(ctx, "imported "+downloadClientTorrentBlackholeResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientTorrentBlackholeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewDownloadClientResource(), "implementation", downloadClientTorrentBlackholeImplementation),
+	}
+}
+
 func (d *DownloadClientTorrentBlackhole) write(ctx context.Context, downloadClient *whisparr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
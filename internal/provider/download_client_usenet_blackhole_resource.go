@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -25,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &DownloadClientUsenetBlackholeResource{}
-	_ resource.ResourceWithImportState = &DownloadClientUsenetBlackholeResource{}
+	_ resource.Resource                 = &DownloadClientUsenetBlackholeResource{}
+	_ resource.ResourceWithImportState  = &DownloadClientUsenetBlackholeResource{}
+	_ resource.ResourceWithMoveState    = &DownloadClientUsenetBlackholeResource{}
+	_ resource.ResourceWithUpgradeState = &DownloadClientUsenetBlackholeResource{}
 )
 
 func NewDownloadClientUsenetBlackholeResource() resource.Resource {
@@ -35,12 +39,13 @@ func NewDownloadClientUsenetBlackholeResource() resource.Resource {
 
 // DownloadClientUsenetBlackholeResource defines the download client implementation.
 type DownloadClientUsenetBlackholeResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // DownloadClientUsenetBlackhole describes the download client data model.
 type DownloadClientUsenetBlackhole struct {
 	Tags                     types.Set    `tfsdk:"tags"`
+	TagLabels                types.Set    `tfsdk:"tag_labels"`
 	Name                     types.String `tfsdk:"name"`
 	NzbFolder                types.String `tfsdk:"nzb_folder"`
 	WatchFolder              types.String `tfsdk:"watch_folder"`
@@ -54,6 +59,7 @@ type DownloadClientUsenetBlackhole struct {
 func (d DownloadClientUsenetBlackhole) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
 		Tags:                     d.Tags,
+		TagLabels:                d.TagLabels,
 		Name:                     d.Name,
 		NzbFolder:                d.NzbFolder,
 		WatchFolder:              d.WatchFolder,
@@ -70,6 +76,7 @@ func (d DownloadClientUsenetBlackhole) toDownloadClient() *DownloadClient {
 
 func (d *DownloadClientUsenetBlackhole) fromDownloadClient(client *DownloadClient) {
 	d.Tags = client.Tags
+	d.TagLabels = client.TagLabels
 	d.Name = client.Name
 	d.NzbFolder = client.NzbFolder
 	d.WatchFolder = client.WatchFolder
@@ -87,6 +94,7 @@ func (r *DownloadClientUsenetBlackholeResource) Metadata(_ context.Context, req
 func (r *DownloadClientUsenetBlackholeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Download Client Usenet Blackhole resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/whisparr/settings#download-clients) and [UsenetBlackhole](https://wiki.servarr.com/whisparr/supported#usenetblackhole).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -118,6 +126,15 @@ func (r *DownloadClientUsenetBlackholeResource) Schema(_ context.Context, _ reso
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -138,6 +155,12 @@ func (r *DownloadClientUsenetBlackholeResource) Schema(_ context.Context, _ reso
 	}
 }
 
+func (r *DownloadClientUsenetBlackholeResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *DownloadClientUsenetBlackholeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -154,12 +177,18 @@ func (r *DownloadClientUsenetBlackholeResource) Create(ctx context.Context, req
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClientUsenetBlackhole
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientUsenetBlackholeResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, downloadClientUsenetBlackholeResourceName, err)...)
 
 		return
 	}
@@ -167,6 +196,7 @@ func (r *DownloadClientUsenetBlackholeResource) Create(ctx context.Context, req
 	tflog.Trace(ctx, "created "+downloadClientUsenetBlackholeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -181,9 +211,11 @@ func (r *DownloadClientUsenetBlackholeResource) Read(ctx context.Context, req re
 	}
 
 	// Get DownloadClientUsenetBlackhole current value
-	response, _, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientApi.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientUsenetBlackholeResourceName, err))
+		if !helpers.RemoveNotFound(ctx, downloadClientUsenetBlackholeResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, downloadClientUsenetBlackholeResourceName, err)...)
+		}
 
 		return
 	}
@@ -191,6 +223,7 @@ func (r *DownloadClientUsenetBlackholeResource) Read(ctx context.Context, req re
 	tflog.Trace(ctx, "read "+downloadClientUsenetBlackholeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -204,12 +237,18 @@ func (r *DownloadClientUsenetBlackholeResource) Update(ctx context.Context, req
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientUsenetBlackhole
 	request := client.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.DownloadClientApi.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientUsenetBlackholeResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, downloadClientUsenetBlackholeResourceName, err)...)
 
 		return
 	}
@@ -217,6 +256,7 @@ func (r *DownloadClientUsenetBlackholeResource) Update(ctx context.Context, req
 	tflog.Trace(ctx, "updated "+downloadClientUsenetBlackholeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
+	client.TagLabels = r.client.TagLabels(ctx, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -232,7 +272,7 @@ func (r *DownloadClientUsenetBlackholeResource) Delete(ctx context.Context, req
 	// Delete DownloadClientUsenetBlackhole current value
 	_, err := r.client.DownloadClientApi.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientUsenetBlackholeResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, downloadClientUsenetBlackholeResourceName, err)...)
 
 		return
 	}
@@ -246,6 +286,12 @@ func (r *DownloadClientUsenetBlackholeResource) ImportState(ctx context.Context,
 	tflog.Trace(ctx, "imported "+downloadClientUsenetBlackholeResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientUsenetBlackholeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewDownloadClientResource(), "implementation", downloadClientUsenetBlackholeImplementation),
+	}
+}
+
 func (d *DownloadClientUsenetBlackhole) write(ctx context.Context, downloadClient *whisparr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,7 +22,7 @@ func NewDownloadClientsDataSource() datasource.DataSource {
 
 // DownloadClientsDataSource defines the download clients implementation.
 type DownloadClientsDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // DownloadClients describes the download clients data model.
@@ -87,6 +86,11 @@ func (d *DownloadClientsDataSource) Schema(_ context.Context, _ datasource.Schem
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"tag_labels": schema.SetAttribute{
+							MarkdownDescription: "List of associated tag labels.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 						"id": schema.Int64Attribute{
 							MarkdownDescription: "Download Client ID.",
 							Computed:            true,
@@ -269,16 +273,19 @@ func (d *DownloadClientsDataSource) Read(ctx context.Context, _ datasource.ReadR
 	// Get download clients current value
 	response, _, err := d.client.DownloadClientApi.ListDownloadClient(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, downloadClientsDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.List, downloadClientsDataSourceName, err)...)
 
 		return
 	}
 
 	tflog.Trace(ctx, "read "+downloadClientsDataSourceName)
+	labels := d.client.TagLabelMap(ctx, &resp.Diagnostics)
+
 	// Map response body to resource schema attribute
 	clients := make([]DownloadClient, len(response))
 	for i, d := range response {
 		clients[i].write(ctx, d, &resp.Diagnostics)
+		clients[i].TagLabels = helpers.MapTagLabels(ctx, clients[i].Tags, labels, &resp.Diagnostics)
 	}
 
 	clientList, diags := types.SetValueFrom(ctx, DownloadClient{}.getType(), clients)
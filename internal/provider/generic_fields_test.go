@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGenericFields ensures every field of the generic resources maps to an attribute of their schema.
+func TestGenericFields(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		resource resource.Resource
+		model    interface{}
+		fields   helpers.Fields
+	}{
+		"download_client": {
+			resource: NewDownloadClientResource(),
+			model:    &DownloadClient{},
+			fields:   downloadClientFields,
+		},
+		"indexer": {
+			resource: NewIndexerResource(),
+			model:    &Indexer{},
+			fields:   indexerFields,
+		},
+		"notification": {
+			resource: NewNotificationResource(),
+			model:    &Notification{},
+			fields:   notificationFields,
+		},
+		"import_list": {
+			resource: NewImportListResource(),
+			model:    &ImportList{},
+			fields:   importListFields,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &resource.SchemaResponse{}
+			test.resource.Schema(context.Background(), resource.SchemaRequest{}, resp)
+
+			attributes, missing := helpers.FieldAttributes(test.model, test.fields)
+			assert.Empty(t, missing)
+
+			for _, attribute := range attributes {
+				_, ok := resp.Schema.Attributes[attribute]
+				assert.True(t, ok, "attribute %q missing from the schema", attribute)
+			}
+		})
+	}
+}
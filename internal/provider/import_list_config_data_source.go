@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,7 +20,7 @@ func NewImportListConfigDataSource() datasource.DataSource {
 
 // ImportListConfigDataSource defines the import list config implementation.
 type ImportListConfigDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *ImportListConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -59,7 +58,7 @@ func (d *ImportListConfigDataSource) Read(ctx context.Context, _ datasource.Read
 	// Get indexer config current value
 	response, _, err := d.client.ImportListConfigApi.GetImportListConfig(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListConfigDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, importListConfigDataSourceName, err)...)
 
 		return
 	}
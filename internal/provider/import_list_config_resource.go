@@ -31,7 +31,7 @@ func NewImportListConfigResource() resource.Resource {
 
 // ImportListConfigResource defines the import list config implementation.
 type ImportListConfigResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // ImportListConfig describes the import list config data model.
@@ -94,7 +94,7 @@ func (r *ImportListConfigResource) Create(ctx context.Context, req resource.Crea
 	// Create new ImportListConfig
 	response, _, err := r.client.ImportListConfigApi.UpdateImportListConfig(ctx, strconv.Itoa(int(request.GetId()))).ImportListConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListConfigResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Create, importListConfigResourceName, err)...)
 
 		return
 	}
@@ -118,7 +118,7 @@ func (r *ImportListConfigResource) Read(ctx context.Context, req resource.ReadRe
 	// Get importListConfig current value
 	response, _, err := r.client.ImportListConfigApi.GetImportListConfig(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListConfigResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, importListConfigResourceName, err)...)
 
 		return
 	}
@@ -145,7 +145,7 @@ func (r *ImportListConfigResource) Update(ctx context.Context, req resource.Upda
 	// Update ImportListConfig
 	response, _, err := r.client.ImportListConfigApi.UpdateImportListConfig(ctx, strconv.Itoa(int(request.GetId()))).ImportListConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListConfigResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Update, importListConfigResourceName, err)...)
 
 		return
 	}
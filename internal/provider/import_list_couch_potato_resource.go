@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -27,8 +28,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ImportListCouchPotatoResource{}
-	_ resource.ResourceWithImportState = &ImportListCouchPotatoResource{}
+	_ resource.Resource                 = &ImportListCouchPotatoResource{}
+	_ resource.ResourceWithImportState  = &ImportListCouchPotatoResource{}
+	_ resource.ResourceWithMoveState    = &ImportListCouchPotatoResource{}
+	_ resource.ResourceWithUpgradeState = &ImportListCouchPotatoResource{}
 )
 
 func NewImportListCouchPotatoResource() resource.Resource {
@@ -37,12 +40,13 @@ func NewImportListCouchPotatoResource() resource.Resource {
 
 // ImportListCouchPotatoResource defines the import list implementation.
 type ImportListCouchPotatoResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // ImportListCouchPotato describes the import list data model.
 type ImportListCouchPotato struct {
 	Tags                types.Set    `tfsdk:"tags"`
+	TagLabels           types.Set    `tfsdk:"tag_labels"`
 	Name                types.String `tfsdk:"name"`
 	MinimumAvailability types.String `tfsdk:"minimum_availability"`
 	RootFolderPath      types.String `tfsdk:"root_folder_path"`
@@ -63,6 +67,7 @@ type ImportListCouchPotato struct {
 func (i ImportListCouchPotato) toImportList() *ImportList {
 	return &ImportList{
 		Tags:                i.Tags,
+		TagLabels:           i.TagLabels,
 		Name:                i.Name,
 		ShouldMonitor:       i.ShouldMonitor,
 		MinimumAvailability: i.MinimumAvailability,
@@ -86,6 +91,7 @@ func (i ImportListCouchPotato) toImportList() *ImportList {
 
 func (i *ImportListCouchPotato) fromImportList(importList *ImportList) {
 	i.Tags = importList.Tags
+	i.TagLabels = importList.TagLabels
 	i.Name = importList.Name
 	i.ShouldMonitor = importList.ShouldMonitor
 	i.MinimumAvailability = importList.MinimumAvailability
@@ -110,6 +116,7 @@ func (r *ImportListCouchPotatoResource) Metadata(_ context.Context, req resource
 func (r *ImportListCouchPotatoResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->Import List Couch Potato resource.\nFor more information refer to [Import List](https://wiki.servarr.com/whisparr/settings#import-lists) and [Couch Potato](https://wiki.servarr.com/whisparr/supported#couchpotatoimport).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
@@ -160,6 +167,15 @@ func (r *ImportListCouchPotatoResource) Schema(_ context.Context, _ resource.Sch
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -194,6 +210,12 @@ func (r *ImportListCouchPotatoResource) Schema(_ context.Context, _ resource.Sch
 	}
 }
 
+func (r *ImportListCouchPotatoResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *ImportListCouchPotatoResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -210,12 +232,18 @@ func (r *ImportListCouchPotatoResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new ImportListCouchPotato
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListCouchPotatoResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, importListCouchPotatoResourceName, err)...)
 
 		return
 	}
@@ -223,6 +251,7 @@ func (r *ImportListCouchPotatoResource) Create(ctx context.Context, req resource
 	tflog.Trace(ctx, "created "+importListCouchPotatoResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -237,9 +266,11 @@ func (r *ImportListCouchPotatoResource) Read(ctx context.Context, req resource.R
 	}
 
 	// Get ImportListCouchPotato current value
-	response, _, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListCouchPotatoResourceName, err))
+		if !helpers.RemoveNotFound(ctx, importListCouchPotatoResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, importListCouchPotatoResourceName, err)...)
+		}
 
 		return
 	}
@@ -247,6 +278,7 @@ func (r *ImportListCouchPotatoResource) Read(ctx context.Context, req resource.R
 	tflog.Trace(ctx, "read "+importListCouchPotatoResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -260,12 +292,18 @@ func (r *ImportListCouchPotatoResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update ImportListCouchPotato
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListCouchPotatoResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, importListCouchPotatoResourceName, err)...)
 
 		return
 	}
@@ -273,6 +311,7 @@ func (r *ImportListCouchPotatoResource) Update(ctx context.Context, req resource
 	tflog.Trace(ctx, "updated "+importListCouchPotatoResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -288,7 +327,7 @@ func (r *ImportListCouchPotatoResource) Delete(ctx context.Context, req resource
 	// Delete ImportListCouchPotato current value
 	_, err := r.client.ImportListApi.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListCouchPotatoResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, importListCouchPotatoResourceName, err)...)
 
 		return
 	}
@@ -302,6 +341,12 @@ func (r *ImportListCouchPotatoResource) ImportState(ctx context.Context, req res
 	tflog.Trace(ctx, "imported "+importListCouchPotatoResourceName+": "+req.ID)
 }
 
+func (r *ImportListCouchPotatoResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewImportListResource(), "config_contract", importListCouchPotatoConfigContract),
+	}
+}
+
 func (i *ImportListCouchPotato) write(ctx context.Context, importList *whisparr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
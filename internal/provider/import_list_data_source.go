@@ -23,7 +23,7 @@ func NewImportListDataSource() datasource.DataSource {
 
 // ImportListDataSource defines the import_list implementation.
 type ImportListDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *ImportListDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -88,6 +88,11 @@ func (d *ImportListDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -291,12 +296,13 @@ func (d *ImportListDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	// Get importList current value
 	response, _, err := d.client.ImportListApi.ListImportList(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, importListDataSourceName, err)...)
 
 		return
 	}
 
 	data.find(ctx, data.Name.ValueString(), response, &resp.Diagnostics)
+	data.TagLabels = d.client.TagLabels(ctx, data.Tags, &resp.Diagnostics)
 	tflog.Trace(ctx, "read "+importListDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -23,7 +23,7 @@ func NewImportListExclusionDataSource() datasource.DataSource {
 
 // ImportListExclusionDataSource defines the importListExclusion implementation.
 type ImportListExclusionDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *ImportListExclusionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -72,7 +72,7 @@ func (d *ImportListExclusionDataSource) Read(ctx context.Context, req datasource
 	// Get importListExclusions current value
 	response, _, err := d.client.ImportExclusionsApi.ListExclusions(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListExclusionDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, importListExclusionDataSourceName, err)...)
 
 		return
 	}
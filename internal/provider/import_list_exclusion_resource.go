@@ -30,7 +30,7 @@ func NewImportListExclusionResource() resource.Resource {
 
 // ImportListExclusionResource defines the importListExclusion implementation.
 type ImportListExclusionResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // ImportListExclusion describes the importListExclusion data model.
@@ -103,7 +103,7 @@ func (r *ImportListExclusionResource) Create(ctx context.Context, req resource.C
 
 	response, _, err := r.client.ImportExclusionsApi.CreateExclusions(ctx).ImportExclusionsResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListExclusionResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Create, importListExclusionResourceName, err)...)
 
 		return
 	}
@@ -125,9 +125,11 @@ func (r *ImportListExclusionResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get importListExclusion current value
-	response, _, err := r.client.ImportExclusionsApi.GetExclusionsById(ctx, int32(importListExclusion.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportExclusionsApi.GetExclusionsById(ctx, int32(importListExclusion.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListExclusionResourceName, err))
+		if !helpers.RemoveNotFound(ctx, importListExclusionResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, importListExclusionResourceName, err)...)
+		}
 
 		return
 	}
@@ -153,7 +155,7 @@ func (r *ImportListExclusionResource) Update(ctx context.Context, req resource.U
 
 	response, _, err := r.client.ImportExclusionsApi.UpdateExclusions(ctx, strconv.Itoa(int(request.GetId()))).ImportExclusionsResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListExclusionResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Update, importListExclusionResourceName, err)...)
 
 		return
 	}
@@ -176,7 +178,7 @@ func (r *ImportListExclusionResource) Delete(ctx context.Context, req resource.D
 	// Delete importListExclusion current value
 	_, err := r.client.ImportExclusionsApi.DeleteExclusions(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListExclusionResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Delete, importListExclusionResourceName, err)...)
 
 		return
 	}
@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,7 +22,7 @@ func NewImportListExclusionsDataSource() datasource.DataSource {
 
 // ImportListExclusionsDataSource defines the importListExclusions implementation.
 type ImportListExclusionsDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // ImportListExclusions describes the importListExclusions data model.
@@ -82,7 +81,7 @@ func (d *ImportListExclusionsDataSource) Read(ctx context.Context, _ datasource.
 	// Get importListExclusions current value
 	response, _, err := d.client.ImportExclusionsApi.ListExclusions(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListExclusionsDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, importListExclusionsDataSourceName, err)...)
 
 		return
 	}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -27,8 +28,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ImportListPlexResource{}
-	_ resource.ResourceWithImportState = &ImportListPlexResource{}
+	_ resource.Resource                 = &ImportListPlexResource{}
+	_ resource.ResourceWithImportState  = &ImportListPlexResource{}
+	_ resource.ResourceWithMoveState    = &ImportListPlexResource{}
+	_ resource.ResourceWithUpgradeState = &ImportListPlexResource{}
 )
 
 func NewImportListPlexResource() resource.Resource {
@@ -37,12 +40,13 @@ func NewImportListPlexResource() resource.Resource {
 
 // ImportListPlexResource defines the import list implementation.
 type ImportListPlexResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // ImportListPlex describes the import list data model.
 type ImportListPlex struct {
 	Tags                types.Set    `tfsdk:"tags"`
+	TagLabels           types.Set    `tfsdk:"tag_labels"`
 	Name                types.String `tfsdk:"name"`
 	MinimumAvailability types.String `tfsdk:"minimum_availability"`
 	RootFolderPath      types.String `tfsdk:"root_folder_path"`
@@ -59,6 +63,7 @@ type ImportListPlex struct {
 func (i ImportListPlex) toImportList() *ImportList {
 	return &ImportList{
 		Tags:                i.Tags,
+		TagLabels:           i.TagLabels,
 		Name:                i.Name,
 		ShouldMonitor:       i.ShouldMonitor,
 		MinimumAvailability: i.MinimumAvailability,
@@ -78,6 +83,7 @@ func (i ImportListPlex) toImportList() *ImportList {
 
 func (i *ImportListPlex) fromImportList(importList *ImportList) {
 	i.Tags = importList.Tags
+	i.TagLabels = importList.TagLabels
 	i.Name = importList.Name
 	i.ShouldMonitor = importList.ShouldMonitor
 	i.MinimumAvailability = importList.MinimumAvailability
@@ -98,6 +104,7 @@ func (r *ImportListPlexResource) Metadata(_ context.Context, req resource.Metada
 func (r *ImportListPlexResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->Import List Plex resource.\nFor more information refer to [Import List](https://wiki.servarr.com/whisparr/settings#import-lists) and [Plex](https://wiki.servarr.com/whisparr/supported#pleximport).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
@@ -148,6 +155,15 @@ func (r *ImportListPlexResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -165,6 +181,12 @@ func (r *ImportListPlexResource) Schema(_ context.Context, _ resource.SchemaRequ
 	}
 }
 
+func (r *ImportListPlexResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *ImportListPlexResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -181,12 +203,18 @@ func (r *ImportListPlexResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new ImportListPlex
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListPlexResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, importListPlexResourceName, err)...)
 
 		return
 	}
@@ -194,6 +222,7 @@ func (r *ImportListPlexResource) Create(ctx context.Context, req resource.Create
 	tflog.Trace(ctx, "created "+importListPlexResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -208,9 +237,11 @@ func (r *ImportListPlexResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get ImportListPlex current value
-	response, _, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListPlexResourceName, err))
+		if !helpers.RemoveNotFound(ctx, importListPlexResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, importListPlexResourceName, err)...)
+		}
 
 		return
 	}
@@ -218,6 +249,7 @@ func (r *ImportListPlexResource) Read(ctx context.Context, req resource.ReadRequ
 	tflog.Trace(ctx, "read "+importListPlexResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -231,12 +263,18 @@ func (r *ImportListPlexResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update ImportListPlex
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListPlexResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, importListPlexResourceName, err)...)
 
 		return
 	}
@@ -244,6 +282,7 @@ func (r *ImportListPlexResource) Update(ctx context.Context, req resource.Update
 	tflog.Trace(ctx, "updated "+importListPlexResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -259,7 +298,7 @@ func (r *ImportListPlexResource) Delete(ctx context.Context, req resource.Delete
 	// Delete ImportListPlex current value
 	_, err := r.client.ImportListApi.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListPlexResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, importListPlexResourceName, err)...)
 
 		return
 	}
@@ -273,6 +312,12 @@ func (r *ImportListPlexResource) ImportState(ctx context.Context, req resource.I
 	tflog.Trace(ctx, "imported "+importListPlexResourceName+": "+req.ID)
 }
 
+func (r *ImportListPlexResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewImportListResource(), "config_contract", importListPlexConfigContract),
+	}
+}
+
 func (i *ImportListPlex) write(ctx context.Context, importList *whisparr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
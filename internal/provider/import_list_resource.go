@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -23,8 +24,10 @@ const importListResourceName = "import_list"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ImportListResource{}
-	_ resource.ResourceWithImportState = &ImportListResource{}
+	_ resource.Resource                     = &ImportListResource{}
+	_ resource.ResourceWithImportState      = &ImportListResource{}
+	_ resource.ResourceWithConfigValidators = &ImportListResource{}
+	_ resource.ResourceWithUpgradeState     = &ImportListResource{}
 )
 
 var importListFields = helpers.Fields{
@@ -42,7 +45,7 @@ func NewImportListResource() resource.Resource {
 
 // ImportListResource defines the import list implementation.
 type ImportListResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // ImportList describes the import list data model.
@@ -50,6 +53,7 @@ type ImportList struct {
 	ProfileIds                types.Set    `tfsdk:"profile_ids"`
 	TagIds                    types.Set    `tfsdk:"tag_ids"`
 	Tags                      types.Set    `tfsdk:"tags"`
+	TagLabels                 types.Set    `tfsdk:"tag_labels"`
 	Name                      types.String `tfsdk:"name"`
 	ConfigContract            types.String `tfsdk:"config_contract"`
 	Implementation            types.String `tfsdk:"implementation"`
@@ -111,6 +115,7 @@ func (i ImportList) getType() attr.Type {
 		map[string]attr.Type{
 			"tag_ids":                     types.SetType{}.WithElementType(types.Int64Type),
 			"tags":                        types.SetType{}.WithElementType(types.Int64Type),
+			"tag_labels":                  types.SetType{}.WithElementType(types.StringType),
 			"profile_ids":                 types.SetType{}.WithElementType(types.Int64Type),
 			"name":                        types.StringType,
 			"config_contract":             types.StringType,
@@ -175,7 +180,8 @@ func (r *ImportListResource) Metadata(_ context.Context, req resource.MetadataRe
 
 func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "<!-- subcategory:Import Lists -->Generic Import List resource. When possible use a specific resource instead.\nFor more information refer to [Import List](https://wiki.servarr.com/whisparr/settings#import-lists).",
+		MarkdownDescription: "<!-- subcategory:Import Lists -->Generic Import List resource. When possible use a specific resource instead, existing ones can be moved there with a `moved` block (Terraform 1.8+).\nFor more information refer to [Import List](https://wiki.servarr.com/whisparr/settings#import-lists).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
@@ -242,6 +248,15 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -474,12 +489,48 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+func (r *ImportListResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *ImportListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 	}
 }
 
+func (r *ImportListResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		helpers.ImplementationValidator{
+			Resources: map[string]func() resource.Resource{
+				importListCouchPotatoConfigContract:    NewImportListCouchPotatoResource,
+				importListCustomConfigContract:         NewImportListCustomResource,
+				importListIMDBConfigContract:           NewImportListIMDBResource,
+				importListPlexConfigContract:           NewImportListPlexResource,
+				importListRSSConfigContract:            NewImportListRSSResource,
+				importListStevenlu2ConfigContract:      NewImportListStevenlu2Resource,
+				importListStevenluConfigContract:       NewImportListStevenluResource,
+				importListTMDBCollectionConfigContract: NewImportListTMDBCollectionResource,
+				importListTMDBCompanyConfigContract:    NewImportListTMDBCompanyResource,
+				importListTMDBKeywordConfigContract:    NewImportListTMDBKeywordResource,
+				importListTMDBListConfigContract:       NewImportListTMDBListResource,
+				importListTMDBPersonConfigContract:     NewImportListTMDBPersonResource,
+				importListTMDBPopularConfigContract:    NewImportListTMDBPopularResource,
+				importListTMDBUserConfigContract:       NewImportListTMDBUserResource,
+				importListTraktListConfigContract:      NewImportListTraktListResource,
+				importListTraktPopularConfigContract:   NewImportListTraktPopularResource,
+				importListTraktUserConfigContract:      NewImportListTraktUserResource,
+				importListWhisparrConfigContract:       NewImportListWhisparrResource,
+			},
+			Attribute: "config_contract",
+			Model:     &ImportList{},
+			Fields:    importListFields,
+		},
+	}
+}
+
 func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var importList *ImportList
@@ -490,12 +541,18 @@ func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new ImportList
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, importListResourceName, err)...)
 
 		return
 	}
@@ -506,6 +563,7 @@ func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequ
 	var state ImportList
 
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = r.client.TagLabels(ctx, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -520,9 +578,11 @@ func (r *ImportListResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Get ImportList current value
-	response, _, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListResourceName, err))
+		if !helpers.RemoveNotFound(ctx, importListResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, importListResourceName, err)...)
+		}
 
 		return
 	}
@@ -533,6 +593,7 @@ func (r *ImportListResource) Read(ctx context.Context, req resource.ReadRequest,
 	var state ImportList
 
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = r.client.TagLabels(ctx, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -546,12 +607,18 @@ func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update ImportList
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, importListResourceName, err)...)
 
 		return
 	}
@@ -562,6 +629,7 @@ func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequ
 	var state ImportList
 
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = r.client.TagLabels(ctx, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -577,7 +645,7 @@ func (r *ImportListResource) Delete(ctx context.Context, req resource.DeleteRequ
 	// Delete ImportList current value
 	_, err := r.client.ImportListApi.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, importListResourceName, err)...)
 
 		return
 	}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -27,8 +28,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ImportListRSSResource{}
-	_ resource.ResourceWithImportState = &ImportListRSSResource{}
+	_ resource.Resource                 = &ImportListRSSResource{}
+	_ resource.ResourceWithImportState  = &ImportListRSSResource{}
+	_ resource.ResourceWithMoveState    = &ImportListRSSResource{}
+	_ resource.ResourceWithUpgradeState = &ImportListRSSResource{}
 )
 
 func NewImportListRSSResource() resource.Resource {
@@ -37,12 +40,13 @@ func NewImportListRSSResource() resource.Resource {
 
 // ImportListRSSResource defines the import list implementation.
 type ImportListRSSResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // ImportListRSS describes the import list data model.
 type ImportListRSS struct {
 	Tags                types.Set    `tfsdk:"tags"`
+	TagLabels           types.Set    `tfsdk:"tag_labels"`
 	Name                types.String `tfsdk:"name"`
 	MinimumAvailability types.String `tfsdk:"minimum_availability"`
 	RootFolderPath      types.String `tfsdk:"root_folder_path"`
@@ -59,6 +63,7 @@ type ImportListRSS struct {
 func (i ImportListRSS) toImportList() *ImportList {
 	return &ImportList{
 		Tags:                i.Tags,
+		TagLabels:           i.TagLabels,
 		Name:                i.Name,
 		ShouldMonitor:       i.ShouldMonitor,
 		MinimumAvailability: i.MinimumAvailability,
@@ -78,6 +83,7 @@ func (i ImportListRSS) toImportList() *ImportList {
 
 func (i *ImportListRSS) fromImportList(importList *ImportList) {
 	i.Tags = importList.Tags
+	i.TagLabels = importList.TagLabels
 	i.Name = importList.Name
 	i.ShouldMonitor = importList.ShouldMonitor
 	i.MinimumAvailability = importList.MinimumAvailability
@@ -98,6 +104,7 @@ func (r *ImportListRSSResource) Metadata(_ context.Context, req resource.Metadat
 func (r *ImportListRSSResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->Import List RSS resource.\nFor more information refer to [Import List](https://wiki.servarr.com/whisparr/settings#import-lists) and [RSS](https://wiki.servarr.com/whisparr/supported#rssimport).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
@@ -148,6 +155,15 @@ func (r *ImportListRSSResource) Schema(_ context.Context, _ resource.SchemaReque
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -164,6 +180,12 @@ func (r *ImportListRSSResource) Schema(_ context.Context, _ resource.SchemaReque
 	}
 }
 
+func (r *ImportListRSSResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *ImportListRSSResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -180,12 +202,18 @@ func (r *ImportListRSSResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new ImportListRSS
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListRSSResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, importListRSSResourceName, err)...)
 
 		return
 	}
@@ -193,6 +221,7 @@ func (r *ImportListRSSResource) Create(ctx context.Context, req resource.CreateR
 	tflog.Trace(ctx, "created "+importListRSSResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -207,9 +236,11 @@ func (r *ImportListRSSResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	// Get ImportListRSS current value
-	response, _, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListRSSResourceName, err))
+		if !helpers.RemoveNotFound(ctx, importListRSSResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, importListRSSResourceName, err)...)
+		}
 
 		return
 	}
@@ -217,6 +248,7 @@ func (r *ImportListRSSResource) Read(ctx context.Context, req resource.ReadReque
 	tflog.Trace(ctx, "read "+importListRSSResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -230,12 +262,18 @@ func (r *ImportListRSSResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update ImportListRSS
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListRSSResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, importListRSSResourceName, err)...)
 
 		return
 	}
@@ -243,6 +281,7 @@ func (r *ImportListRSSResource) Update(ctx context.Context, req resource.UpdateR
 	tflog.Trace(ctx, "updated "+importListRSSResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -258,7 +297,7 @@ func (r *ImportListRSSResource) Delete(ctx context.Context, req resource.DeleteR
 	// Delete ImportListRSS current value
 	_, err := r.client.ImportListApi.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListRSSResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, importListRSSResourceName, err)...)
 
 		return
 	}
@@ -272,6 +311,12 @@ func (r *ImportListRSSResource) ImportState(ctx context.Context, req resource.Im
 	tflog.Trace(ctx, "imported "+importListRSSResourceName+": "+req.ID)
 }
 
+func (r *ImportListRSSResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewImportListResource(), "config_contract", importListRSSConfigContract),
+	}
+}
+
 func (i *ImportListRSS) write(ctx context.Context, importList *whisparr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
@@ -7,6 +7,7 @@ import (
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,8 +29,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ImportListStevenlu2Resource{}
-	_ resource.ResourceWithImportState = &ImportListStevenlu2Resource{}
+	_ resource.Resource                 = &ImportListStevenlu2Resource{}
+	_ resource.ResourceWithImportState  = &ImportListStevenlu2Resource{}
+	_ resource.ResourceWithMoveState    = &ImportListStevenlu2Resource{}
+	_ resource.ResourceWithUpgradeState = &ImportListStevenlu2Resource{}
 )
 
 func NewImportListStevenlu2Resource() resource.Resource {
@@ -38,12 +41,13 @@ func NewImportListStevenlu2Resource() resource.Resource {
 
 // ImportListStevenlu2Resource defines the import list implementation.
 type ImportListStevenlu2Resource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // ImportListStevenlu2 describes the import list data model.
 type ImportListStevenlu2 struct {
 	Tags                types.Set    `tfsdk:"tags"`
+	TagLabels           types.Set    `tfsdk:"tag_labels"`
 	Name                types.String `tfsdk:"name"`
 	MinimumAvailability types.String `tfsdk:"minimum_availability"`
 	RootFolderPath      types.String `tfsdk:"root_folder_path"`
@@ -61,6 +65,7 @@ type ImportListStevenlu2 struct {
 func (i ImportListStevenlu2) toImportList() *ImportList {
 	return &ImportList{
 		Tags:                i.Tags,
+		TagLabels:           i.TagLabels,
 		Name:                i.Name,
 		ShouldMonitor:       i.ShouldMonitor,
 		MinimumAvailability: i.MinimumAvailability,
@@ -81,6 +86,7 @@ func (i ImportListStevenlu2) toImportList() *ImportList {
 
 func (i *ImportListStevenlu2) fromImportList(importList *ImportList) {
 	i.Tags = importList.Tags
+	i.TagLabels = importList.TagLabels
 	i.Name = importList.Name
 	i.ShouldMonitor = importList.ShouldMonitor
 	i.MinimumAvailability = importList.MinimumAvailability
@@ -102,6 +108,7 @@ func (r *ImportListStevenlu2Resource) Metadata(_ context.Context, req resource.M
 func (r *ImportListStevenlu2Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->Import List Stevenlu2 resource.\nFor more information refer to [Import List](https://wiki.servarr.com/whisparr/settings#import-lists) and [Stevenlu2](https://wiki.servarr.com/whisparr/supported#stevenlu2import).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
@@ -152,6 +159,15 @@ func (r *ImportListStevenlu2Resource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -175,6 +191,12 @@ func (r *ImportListStevenlu2Resource) Schema(_ context.Context, _ resource.Schem
 	}
 }
 
+func (r *ImportListStevenlu2Resource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *ImportListStevenlu2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -191,12 +213,18 @@ func (r *ImportListStevenlu2Resource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new ImportListStevenlu2
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListStevenlu2ResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, importListStevenlu2ResourceName, err)...)
 
 		return
 	}
@@ -204,6 +232,7 @@ func (r *ImportListStevenlu2Resource) Create(ctx context.Context, req resource.C
 	tflog.Trace(ctx, "created "+importListStevenlu2ResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -218,9 +247,11 @@ func (r *ImportListStevenlu2Resource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get ImportListStevenlu2 current value
-	response, _, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListStevenlu2ResourceName, err))
+		if !helpers.RemoveNotFound(ctx, importListStevenlu2ResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, importListStevenlu2ResourceName, err)...)
+		}
 
 		return
 	}
@@ -228,6 +259,7 @@ func (r *ImportListStevenlu2Resource) Read(ctx context.Context, req resource.Rea
 	tflog.Trace(ctx, "read "+importListStevenlu2ResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -241,12 +273,18 @@ func (r *ImportListStevenlu2Resource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update ImportListStevenlu2
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListStevenlu2ResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, importListStevenlu2ResourceName, err)...)
 
 		return
 	}
@@ -254,6 +292,7 @@ func (r *ImportListStevenlu2Resource) Update(ctx context.Context, req resource.U
 	tflog.Trace(ctx, "updated "+importListStevenlu2ResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -269,7 +308,7 @@ func (r *ImportListStevenlu2Resource) Delete(ctx context.Context, req resource.D
 	// Delete ImportListStevenlu2 current value
 	_, err := r.client.ImportListApi.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListStevenlu2ResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, importListStevenlu2ResourceName, err)...)
 
 		return
 	}
@@ -283,6 +322,12 @@ func (r *ImportListStevenlu2Resource) ImportState(ctx context.Context, req resou
 	tflog.Trace(ctx, "imported "+importListStevenlu2ResourceName+": "+req.ID)
 }
 
+func (r *ImportListStevenlu2Resource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewImportListResource(), "config_contract", importListStevenlu2ConfigContract),
+	}
+}
+
 func (i *ImportListStevenlu2) write(ctx context.Context, importList *whisparr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -27,8 +28,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ImportListTMDBPersonResource{}
-	_ resource.ResourceWithImportState = &ImportListTMDBPersonResource{}
+	_ resource.Resource                 = &ImportListTMDBPersonResource{}
+	_ resource.ResourceWithImportState  = &ImportListTMDBPersonResource{}
+	_ resource.ResourceWithMoveState    = &ImportListTMDBPersonResource{}
+	_ resource.ResourceWithUpgradeState = &ImportListTMDBPersonResource{}
 )
 
 func NewImportListTMDBPersonResource() resource.Resource {
@@ -37,12 +40,13 @@ func NewImportListTMDBPersonResource() resource.Resource {
 
 // ImportListTMDBPersonResource defines the import list implementation.
 type ImportListTMDBPersonResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // ImportListTMDBPerson describes the import list data model.
 type ImportListTMDBPerson struct {
 	Tags                types.Set    `tfsdk:"tags"`
+	TagLabels           types.Set    `tfsdk:"tag_labels"`
 	Name                types.String `tfsdk:"name"`
 	MinimumAvailability types.String `tfsdk:"minimum_availability"`
 	RootFolderPath      types.String `tfsdk:"root_folder_path"`
@@ -64,6 +68,7 @@ type ImportListTMDBPerson struct {
 func (i ImportListTMDBPerson) toImportList() *ImportList {
 	return &ImportList{
 		Tags:                i.Tags,
+		TagLabels:           i.TagLabels,
 		Name:                i.Name,
 		ShouldMonitor:       i.ShouldMonitor,
 		MinimumAvailability: i.MinimumAvailability,
@@ -88,6 +93,7 @@ func (i ImportListTMDBPerson) toImportList() *ImportList {
 
 func (i *ImportListTMDBPerson) fromImportList(importList *ImportList) {
 	i.Tags = importList.Tags
+	i.TagLabels = importList.TagLabels
 	i.Name = importList.Name
 	i.ShouldMonitor = importList.ShouldMonitor
 	i.MinimumAvailability = importList.MinimumAvailability
@@ -113,6 +119,7 @@ func (r *ImportListTMDBPersonResource) Metadata(_ context.Context, req resource.
 func (r *ImportListTMDBPersonResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->Import List TMDB Person resource.\nFor more information refer to [Import List](https://wiki.servarr.com/whisparr/settings#import-lists) and [TMDB Person](https://wiki.servarr.com/whisparr/supported#tmdbpersonimport).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
@@ -163,6 +170,15 @@ func (r *ImportListTMDBPersonResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -204,6 +220,12 @@ func (r *ImportListTMDBPersonResource) Schema(_ context.Context, _ resource.Sche
 	}
 }
 
+func (r *ImportListTMDBPersonResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *ImportListTMDBPersonResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -220,12 +242,18 @@ func (r *ImportListTMDBPersonResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new ImportListTMDBPerson
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListTMDBPersonResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, importListTMDBPersonResourceName, err)...)
 
 		return
 	}
@@ -233,6 +261,7 @@ func (r *ImportListTMDBPersonResource) Create(ctx context.Context, req resource.
 	tflog.Trace(ctx, "created "+importListTMDBPersonResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -247,9 +276,11 @@ func (r *ImportListTMDBPersonResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get ImportListTMDBPerson current value
-	response, _, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListTMDBPersonResourceName, err))
+		if !helpers.RemoveNotFound(ctx, importListTMDBPersonResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, importListTMDBPersonResourceName, err)...)
+		}
 
 		return
 	}
@@ -257,6 +288,7 @@ func (r *ImportListTMDBPersonResource) Read(ctx context.Context, req resource.Re
 	tflog.Trace(ctx, "read "+importListTMDBPersonResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -270,12 +302,18 @@ func (r *ImportListTMDBPersonResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update ImportListTMDBPerson
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListTMDBPersonResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, importListTMDBPersonResourceName, err)...)
 
 		return
 	}
@@ -283,6 +321,7 @@ func (r *ImportListTMDBPersonResource) Update(ctx context.Context, req resource.
 	tflog.Trace(ctx, "updated "+importListTMDBPersonResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -298,7 +337,7 @@ func (r *ImportListTMDBPersonResource) Delete(ctx context.Context, req resource.
 	// Delete ImportListTMDBPerson current value
 	_, err := r.client.ImportListApi.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListTMDBPersonResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, importListTMDBPersonResourceName, err)...)
 
 		return
 	}
@@ -312,6 +351,12 @@ func (r *ImportListTMDBPersonResource) ImportState(ctx context.Context, req reso
 	tflog.Trace(ctx, "imported "+importListTMDBPersonResourceName+": "+req.ID)
 }
 
+func (r *ImportListTMDBPersonResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewImportListResource(), "config_contract", importListTMDBPersonConfigContract),
+	}
+}
+
 func (i *ImportListTMDBPerson) write(ctx context.Context, importList *whisparr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
@@ -7,6 +7,7 @@ import (
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,8 +29,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ImportListTMDBPopularResource{}
-	_ resource.ResourceWithImportState = &ImportListTMDBPopularResource{}
+	_ resource.Resource                 = &ImportListTMDBPopularResource{}
+	_ resource.ResourceWithImportState  = &ImportListTMDBPopularResource{}
+	_ resource.ResourceWithMoveState    = &ImportListTMDBPopularResource{}
+	_ resource.ResourceWithUpgradeState = &ImportListTMDBPopularResource{}
 )
 
 func NewImportListTMDBPopularResource() resource.Resource {
@@ -38,12 +41,13 @@ func NewImportListTMDBPopularResource() resource.Resource {
 
 // ImportListTMDBPopularResource defines the import list implementation.
 type ImportListTMDBPopularResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // ImportListTMDBPopular describes the import list data model.
 type ImportListTMDBPopular struct {
 	Tags                types.Set    `tfsdk:"tags"`
+	TagLabels           types.Set    `tfsdk:"tag_labels"`
 	Name                types.String `tfsdk:"name"`
 	MinimumAvailability types.String `tfsdk:"minimum_availability"`
 	RootFolderPath      types.String `tfsdk:"root_folder_path"`
@@ -66,6 +70,7 @@ type ImportListTMDBPopular struct {
 func (i ImportListTMDBPopular) toImportList() *ImportList {
 	return &ImportList{
 		Tags:                i.Tags,
+		TagLabels:           i.TagLabels,
 		Name:                i.Name,
 		ShouldMonitor:       i.ShouldMonitor,
 		MinimumAvailability: i.MinimumAvailability,
@@ -91,6 +96,7 @@ func (i ImportListTMDBPopular) toImportList() *ImportList {
 
 func (i *ImportListTMDBPopular) fromImportList(importList *ImportList) {
 	i.Tags = importList.Tags
+	i.TagLabels = importList.TagLabels
 	i.Name = importList.Name
 	i.ShouldMonitor = importList.ShouldMonitor
 	i.MinimumAvailability = importList.MinimumAvailability
@@ -117,6 +123,7 @@ func (r *ImportListTMDBPopularResource) Metadata(_ context.Context, req resource
 func (r *ImportListTMDBPopularResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->Import List TMDB Popular resource.\nFor more information refer to [Import List](https://wiki.servarr.com/whisparr/settings#import-lists) and [TMDB Popular](https://wiki.servarr.com/whisparr/supported#tmdbpopularimport).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
@@ -167,6 +174,15 @@ func (r *ImportListTMDBPopularResource) Schema(_ context.Context, _ resource.Sch
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -215,6 +231,12 @@ func (r *ImportListTMDBPopularResource) Schema(_ context.Context, _ resource.Sch
 	}
 }
 
+func (r *ImportListTMDBPopularResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *ImportListTMDBPopularResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -231,12 +253,18 @@ func (r *ImportListTMDBPopularResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new ImportListTMDBPopular
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListTMDBPopularResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, importListTMDBPopularResourceName, err)...)
 
 		return
 	}
@@ -244,6 +272,7 @@ func (r *ImportListTMDBPopularResource) Create(ctx context.Context, req resource
 	tflog.Trace(ctx, "created "+importListTMDBPopularResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -258,9 +287,11 @@ func (r *ImportListTMDBPopularResource) Read(ctx context.Context, req resource.R
 	}
 
 	// Get ImportListTMDBPopular current value
-	response, _, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListTMDBPopularResourceName, err))
+		if !helpers.RemoveNotFound(ctx, importListTMDBPopularResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, importListTMDBPopularResourceName, err)...)
+		}
 
 		return
 	}
@@ -268,6 +299,7 @@ func (r *ImportListTMDBPopularResource) Read(ctx context.Context, req resource.R
 	tflog.Trace(ctx, "read "+importListTMDBPopularResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -281,12 +313,18 @@ func (r *ImportListTMDBPopularResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update ImportListTMDBPopular
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListTMDBPopularResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, importListTMDBPopularResourceName, err)...)
 
 		return
 	}
@@ -294,6 +332,7 @@ func (r *ImportListTMDBPopularResource) Update(ctx context.Context, req resource
 	tflog.Trace(ctx, "updated "+importListTMDBPopularResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -309,7 +348,7 @@ func (r *ImportListTMDBPopularResource) Delete(ctx context.Context, req resource
 	// Delete ImportListTMDBPopular current value
 	_, err := r.client.ImportListApi.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListTMDBPopularResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, importListTMDBPopularResourceName, err)...)
 
 		return
 	}
@@ -323,6 +362,12 @@ func (r *ImportListTMDBPopularResource) ImportState(ctx context.Context, req res
 	tflog.Trace(ctx, "imported "+importListTMDBPopularResourceName+": "+req.ID)
 }
 
+func (r *ImportListTMDBPopularResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewImportListResource(), "config_contract", importListTMDBPopularConfigContract),
+	}
+}
+
 func (i *ImportListTMDBPopular) write(ctx context.Context, importList *whisparr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
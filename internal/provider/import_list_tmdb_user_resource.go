@@ -7,6 +7,7 @@ import (
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,8 +29,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ImportListTMDBUserResource{}
-	_ resource.ResourceWithImportState = &ImportListTMDBUserResource{}
+	_ resource.Resource                 = &ImportListTMDBUserResource{}
+	_ resource.ResourceWithImportState  = &ImportListTMDBUserResource{}
+	_ resource.ResourceWithMoveState    = &ImportListTMDBUserResource{}
+	_ resource.ResourceWithUpgradeState = &ImportListTMDBUserResource{}
 )
 
 func NewImportListTMDBUserResource() resource.Resource {
@@ -38,12 +41,13 @@ func NewImportListTMDBUserResource() resource.Resource {
 
 // ImportListTMDBUserResource defines the import list implementation.
 type ImportListTMDBUserResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // ImportListTMDBUser describes the import list data model.
 type ImportListTMDBUser struct {
 	Tags                types.Set    `tfsdk:"tags"`
+	TagLabels           types.Set    `tfsdk:"tag_labels"`
 	Name                types.String `tfsdk:"name"`
 	MinimumAvailability types.String `tfsdk:"minimum_availability"`
 	RootFolderPath      types.String `tfsdk:"root_folder_path"`
@@ -62,6 +66,7 @@ type ImportListTMDBUser struct {
 func (i ImportListTMDBUser) toImportList() *ImportList {
 	return &ImportList{
 		Tags:                i.Tags,
+		TagLabels:           i.TagLabels,
 		Name:                i.Name,
 		ShouldMonitor:       i.ShouldMonitor,
 		MinimumAvailability: i.MinimumAvailability,
@@ -83,6 +88,7 @@ func (i ImportListTMDBUser) toImportList() *ImportList {
 
 func (i *ImportListTMDBUser) fromImportList(importList *ImportList) {
 	i.Tags = importList.Tags
+	i.TagLabels = importList.TagLabels
 	i.Name = importList.Name
 	i.ShouldMonitor = importList.ShouldMonitor
 	i.MinimumAvailability = importList.MinimumAvailability
@@ -105,6 +111,7 @@ func (r *ImportListTMDBUserResource) Metadata(_ context.Context, req resource.Me
 func (r *ImportListTMDBUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->Import List TMDB User resource.\nFor more information refer to [Import List](https://wiki.servarr.com/whisparr/settings#import-lists) and [TMDB User](https://wiki.servarr.com/whisparr/supported#tmdbuserimport).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
@@ -155,6 +162,15 @@ func (r *ImportListTMDBUserResource) Schema(_ context.Context, _ resource.Schema
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -183,6 +199,12 @@ func (r *ImportListTMDBUserResource) Schema(_ context.Context, _ resource.Schema
 	}
 }
 
+func (r *ImportListTMDBUserResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *ImportListTMDBUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -199,12 +221,18 @@ func (r *ImportListTMDBUserResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new ImportListTMDBUser
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListTMDBUserResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, importListTMDBUserResourceName, err)...)
 
 		return
 	}
@@ -212,6 +240,7 @@ func (r *ImportListTMDBUserResource) Create(ctx context.Context, req resource.Cr
 	tflog.Trace(ctx, "created "+importListTMDBUserResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -226,9 +255,11 @@ func (r *ImportListTMDBUserResource) Read(ctx context.Context, req resource.Read
 	}
 
 	// Get ImportListTMDBUser current value
-	response, _, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListTMDBUserResourceName, err))
+		if !helpers.RemoveNotFound(ctx, importListTMDBUserResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, importListTMDBUserResourceName, err)...)
+		}
 
 		return
 	}
@@ -236,6 +267,7 @@ func (r *ImportListTMDBUserResource) Read(ctx context.Context, req resource.Read
 	tflog.Trace(ctx, "read "+importListTMDBUserResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -249,12 +281,18 @@ func (r *ImportListTMDBUserResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update ImportListTMDBUser
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListTMDBUserResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, importListTMDBUserResourceName, err)...)
 
 		return
 	}
@@ -262,6 +300,7 @@ func (r *ImportListTMDBUserResource) Update(ctx context.Context, req resource.Up
 	tflog.Trace(ctx, "updated "+importListTMDBUserResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -277,7 +316,7 @@ func (r *ImportListTMDBUserResource) Delete(ctx context.Context, req resource.De
 	// Delete ImportListTMDBUser current value
 	_, err := r.client.ImportListApi.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListTMDBUserResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, importListTMDBUserResourceName, err)...)
 
 		return
 	}
@@ -291,6 +330,12 @@ func (r *ImportListTMDBUserResource) ImportState(ctx context.Context, req resour
 	tflog.Trace(ctx, "imported "+importListTMDBUserResourceName+": "+req.ID)
 }
 
+func (r *ImportListTMDBUserResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewImportListResource(), "config_contract", importListTMDBUserConfigContract),
+	}
+}
+
 func (i *ImportListTMDBUser) write(ctx context.Context, importList *whisparr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -27,8 +28,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ImportListTraktListResource{}
-	_ resource.ResourceWithImportState = &ImportListTraktListResource{}
+	_ resource.Resource                 = &ImportListTraktListResource{}
+	_ resource.ResourceWithImportState  = &ImportListTraktListResource{}
+	_ resource.ResourceWithMoveState    = &ImportListTraktListResource{}
+	_ resource.ResourceWithUpgradeState = &ImportListTraktListResource{}
 )
 
 func NewImportListTraktListResource() resource.Resource {
@@ -37,12 +40,13 @@ func NewImportListTraktListResource() resource.Resource {
 
 // ImportListTraktListResource defines the import list implementation.
 type ImportListTraktListResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // ImportListTraktList describes the import list data model.
 type ImportListTraktList struct {
 	Tags                      types.Set    `tfsdk:"tags"`
+	TagLabels                 types.Set    `tfsdk:"tag_labels"`
 	Name                      types.String `tfsdk:"name"`
 	MinimumAvailability       types.String `tfsdk:"minimum_availability"`
 	RootFolderPath            types.String `tfsdk:"root_folder_path"`
@@ -66,6 +70,7 @@ type ImportListTraktList struct {
 func (i ImportListTraktList) toImportList() *ImportList {
 	return &ImportList{
 		Tags:                      i.Tags,
+		TagLabels:                 i.TagLabels,
 		Name:                      i.Name,
 		ShouldMonitor:             i.ShouldMonitor,
 		MinimumAvailability:       i.MinimumAvailability,
@@ -92,6 +97,7 @@ func (i ImportListTraktList) toImportList() *ImportList {
 
 func (i *ImportListTraktList) fromImportList(importList *ImportList) {
 	i.Tags = importList.Tags
+	i.TagLabels = importList.TagLabels
 	i.Name = importList.Name
 	i.ShouldMonitor = importList.ShouldMonitor
 	i.MinimumAvailability = importList.MinimumAvailability
@@ -119,6 +125,7 @@ func (r *ImportListTraktListResource) Metadata(_ context.Context, req resource.M
 func (r *ImportListTraktListResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->Import List TraktList resource.\nFor more information refer to [Import List](https://wiki.servarr.com/whisparr/settings#import-lists) and [Trakt List](https://wiki.servarr.com/whisparr/supported#traktlistimport).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
@@ -169,6 +176,15 @@ func (r *ImportListTraktListResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -218,6 +234,12 @@ func (r *ImportListTraktListResource) Schema(_ context.Context, _ resource.Schem
 	}
 }
 
+func (r *ImportListTraktListResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *ImportListTraktListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -234,12 +256,18 @@ func (r *ImportListTraktListResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new ImportListTraktList
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListTraktListResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, importListTraktListResourceName, err)...)
 
 		return
 	}
@@ -247,6 +275,7 @@ func (r *ImportListTraktListResource) Create(ctx context.Context, req resource.C
 	tflog.Trace(ctx, "created "+importListTraktListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -261,9 +290,11 @@ func (r *ImportListTraktListResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get ImportListTraktList current value
-	response, _, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListTraktListResourceName, err))
+		if !helpers.RemoveNotFound(ctx, importListTraktListResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, importListTraktListResourceName, err)...)
+		}
 
 		return
 	}
@@ -271,6 +302,7 @@ func (r *ImportListTraktListResource) Read(ctx context.Context, req resource.Rea
 	tflog.Trace(ctx, "read "+importListTraktListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -284,12 +316,18 @@ func (r *ImportListTraktListResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update ImportListTraktList
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListTraktListResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, importListTraktListResourceName, err)...)
 
 		return
 	}
@@ -297,6 +335,7 @@ func (r *ImportListTraktListResource) Update(ctx context.Context, req resource.U
 	tflog.Trace(ctx, "updated "+importListTraktListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -312,7 +351,7 @@ func (r *ImportListTraktListResource) Delete(ctx context.Context, req resource.D
 	// Delete ImportListTraktList current value
 	_, err := r.client.ImportListApi.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListTraktListResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, importListTraktListResourceName, err)...)
 
 		return
 	}
@@ -326,6 +365,12 @@ func (r *ImportListTraktListResource) ImportState(ctx context.Context, req resou
 	tflog.Trace(ctx, "imported "+importListTraktListResourceName+": "+req.ID)
 }
 
+func (r *ImportListTraktListResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewImportListResource(), "config_contract", importListTraktListConfigContract),
+	}
+}
+
 func (i *ImportListTraktList) write(ctx context.Context, importList *whisparr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
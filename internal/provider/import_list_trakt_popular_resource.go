@@ -7,6 +7,7 @@ import (
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,8 +29,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ImportListTraktPopularResource{}
-	_ resource.ResourceWithImportState = &ImportListTraktPopularResource{}
+	_ resource.Resource                 = &ImportListTraktPopularResource{}
+	_ resource.ResourceWithImportState  = &ImportListTraktPopularResource{}
+	_ resource.ResourceWithMoveState    = &ImportListTraktPopularResource{}
+	_ resource.ResourceWithUpgradeState = &ImportListTraktPopularResource{}
 )
 
 func NewImportListTraktPopularResource() resource.Resource {
@@ -38,12 +41,13 @@ func NewImportListTraktPopularResource() resource.Resource {
 
 // ImportListTraktPopularResource defines the import list implementation.
 type ImportListTraktPopularResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // ImportListTraktPopular describes the import list data model.
 type ImportListTraktPopular struct {
 	Tags                      types.Set    `tfsdk:"tags"`
+	TagLabels                 types.Set    `tfsdk:"tag_labels"`
 	Name                      types.String `tfsdk:"name"`
 	MinimumAvailability       types.String `tfsdk:"minimum_availability"`
 	RootFolderPath            types.String `tfsdk:"root_folder_path"`
@@ -70,6 +74,7 @@ type ImportListTraktPopular struct {
 func (i ImportListTraktPopular) toImportList() *ImportList {
 	return &ImportList{
 		Tags:                      i.Tags,
+		TagLabels:                 i.TagLabels,
 		Name:                      i.Name,
 		ShouldMonitor:             i.ShouldMonitor,
 		MinimumAvailability:       i.MinimumAvailability,
@@ -99,6 +104,7 @@ func (i ImportListTraktPopular) toImportList() *ImportList {
 
 func (i *ImportListTraktPopular) fromImportList(importList *ImportList) {
 	i.Tags = importList.Tags
+	i.TagLabels = importList.TagLabels
 	i.Name = importList.Name
 	i.ShouldMonitor = importList.ShouldMonitor
 	i.MinimumAvailability = importList.MinimumAvailability
@@ -129,6 +135,7 @@ func (r *ImportListTraktPopularResource) Metadata(_ context.Context, req resourc
 func (r *ImportListTraktPopularResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->Import List Trakt Popular resource.\nFor more information refer to [Import List](https://wiki.servarr.com/whisparr/settings#import-lists) and [Trakt Popular](https://wiki.servarr.com/whisparr/supported#traktpopularimport).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
@@ -179,6 +186,15 @@ func (r *ImportListTraktPopularResource) Schema(_ context.Context, _ resource.Sc
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -248,6 +264,12 @@ func (r *ImportListTraktPopularResource) Schema(_ context.Context, _ resource.Sc
 	}
 }
 
+func (r *ImportListTraktPopularResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *ImportListTraktPopularResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -264,12 +286,18 @@ func (r *ImportListTraktPopularResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new ImportListTraktPopular
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListTraktPopularResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, importListTraktPopularResourceName, err)...)
 
 		return
 	}
@@ -277,6 +305,7 @@ func (r *ImportListTraktPopularResource) Create(ctx context.Context, req resourc
 	tflog.Trace(ctx, "created "+importListTraktPopularResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -291,9 +320,11 @@ func (r *ImportListTraktPopularResource) Read(ctx context.Context, req resource.
 	}
 
 	// Get ImportListTraktPopular current value
-	response, _, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListTraktPopularResourceName, err))
+		if !helpers.RemoveNotFound(ctx, importListTraktPopularResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, importListTraktPopularResourceName, err)...)
+		}
 
 		return
 	}
@@ -301,6 +332,7 @@ func (r *ImportListTraktPopularResource) Read(ctx context.Context, req resource.
 	tflog.Trace(ctx, "read "+importListTraktPopularResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -314,12 +346,18 @@ func (r *ImportListTraktPopularResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update ImportListTraktPopular
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListTraktPopularResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, importListTraktPopularResourceName, err)...)
 
 		return
 	}
@@ -327,6 +365,7 @@ func (r *ImportListTraktPopularResource) Update(ctx context.Context, req resourc
 	tflog.Trace(ctx, "updated "+importListTraktPopularResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -342,7 +381,7 @@ func (r *ImportListTraktPopularResource) Delete(ctx context.Context, req resourc
 	// Delete ImportListTraktPopular current value
 	_, err := r.client.ImportListApi.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListTraktPopularResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, importListTraktPopularResourceName, err)...)
 
 		return
 	}
@@ -356,6 +395,12 @@ func (r *ImportListTraktPopularResource) ImportState(ctx context.Context, req re
 	tflog.Trace(ctx, "imported "+importListTraktPopularResourceName+": "+req.ID)
 }
 
+func (r *ImportListTraktPopularResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewImportListResource(), "config_contract", importListTraktPopularConfigContract),
+	}
+}
+
 func (i *ImportListTraktPopular) write(ctx context.Context, importList *whisparr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
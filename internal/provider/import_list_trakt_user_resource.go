@@ -7,6 +7,7 @@ import (
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,8 +29,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ImportListTraktUserResource{}
-	_ resource.ResourceWithImportState = &ImportListTraktUserResource{}
+	_ resource.Resource                 = &ImportListTraktUserResource{}
+	_ resource.ResourceWithImportState  = &ImportListTraktUserResource{}
+	_ resource.ResourceWithMoveState    = &ImportListTraktUserResource{}
+	_ resource.ResourceWithUpgradeState = &ImportListTraktUserResource{}
 )
 
 func NewImportListTraktUserResource() resource.Resource {
@@ -38,12 +41,13 @@ func NewImportListTraktUserResource() resource.Resource {
 
 // ImportListTraktUserResource defines the import list implementation.
 type ImportListTraktUserResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // ImportListTraktUser describes the import list data model.
 type ImportListTraktUser struct {
 	Tags                      types.Set    `tfsdk:"tags"`
+	TagLabels                 types.Set    `tfsdk:"tag_labels"`
 	Name                      types.String `tfsdk:"name"`
 	MinimumAvailability       types.String `tfsdk:"minimum_availability"`
 	RootFolderPath            types.String `tfsdk:"root_folder_path"`
@@ -67,6 +71,7 @@ type ImportListTraktUser struct {
 func (i ImportListTraktUser) toImportList() *ImportList {
 	return &ImportList{
 		Tags:                      i.Tags,
+		TagLabels:                 i.TagLabels,
 		Name:                      i.Name,
 		ShouldMonitor:             i.ShouldMonitor,
 		MinimumAvailability:       i.MinimumAvailability,
@@ -93,6 +98,7 @@ func (i ImportListTraktUser) toImportList() *ImportList {
 
 func (i *ImportListTraktUser) fromImportList(importList *ImportList) {
 	i.Tags = importList.Tags
+	i.TagLabels = importList.TagLabels
 	i.Name = importList.Name
 	i.ShouldMonitor = importList.ShouldMonitor
 	i.MinimumAvailability = importList.MinimumAvailability
@@ -120,6 +126,7 @@ func (r *ImportListTraktUserResource) Metadata(_ context.Context, req resource.M
 func (r *ImportListTraktUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->Import List Trakt User resource.\nFor more information refer to [Import List](https://wiki.servarr.com/whisparr/settings#import-lists) and [Trakt User](https://wiki.servarr.com/whisparr/supported#traktuserimport).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
@@ -170,6 +177,15 @@ func (r *ImportListTraktUserResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -224,6 +240,12 @@ func (r *ImportListTraktUserResource) Schema(_ context.Context, _ resource.Schem
 	}
 }
 
+func (r *ImportListTraktUserResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *ImportListTraktUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -240,12 +262,18 @@ func (r *ImportListTraktUserResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new ImportListTraktUser
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListTraktUserResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, importListTraktUserResourceName, err)...)
 
 		return
 	}
@@ -253,6 +281,7 @@ func (r *ImportListTraktUserResource) Create(ctx context.Context, req resource.C
 	tflog.Trace(ctx, "created "+importListTraktUserResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -267,9 +296,11 @@ func (r *ImportListTraktUserResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get ImportListTraktUser current value
-	response, _, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListTraktUserResourceName, err))
+		if !helpers.RemoveNotFound(ctx, importListTraktUserResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, importListTraktUserResourceName, err)...)
+		}
 
 		return
 	}
@@ -277,6 +308,7 @@ func (r *ImportListTraktUserResource) Read(ctx context.Context, req resource.Rea
 	tflog.Trace(ctx, "read "+importListTraktUserResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -290,12 +322,18 @@ func (r *ImportListTraktUserResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update ImportListTraktUser
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListTraktUserResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, importListTraktUserResourceName, err)...)
 
 		return
 	}
@@ -303,6 +341,7 @@ func (r *ImportListTraktUserResource) Update(ctx context.Context, req resource.U
 	tflog.Trace(ctx, "updated "+importListTraktUserResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -318,7 +357,7 @@ func (r *ImportListTraktUserResource) Delete(ctx context.Context, req resource.D
 	// Delete ImportListTraktUser current value
 	_, err := r.client.ImportListApi.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListTraktUserResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, importListTraktUserResourceName, err)...)
 
 		return
 	}
@@ -332,6 +371,12 @@ func (r *ImportListTraktUserResource) ImportState(ctx context.Context, req resou
 	tflog.Trace(ctx, "imported "+importListTraktUserResourceName+": "+req.ID)
 }
 
+func (r *ImportListTraktUserResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewImportListResource(), "config_contract", importListTraktUserConfigContract),
+	}
+}
+
 func (i *ImportListTraktUser) write(ctx context.Context, importList *whisparr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -27,8 +28,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ImportListWhisparrResource{}
-	_ resource.ResourceWithImportState = &ImportListWhisparrResource{}
+	_ resource.Resource                 = &ImportListWhisparrResource{}
+	_ resource.ResourceWithImportState  = &ImportListWhisparrResource{}
+	_ resource.ResourceWithMoveState    = &ImportListWhisparrResource{}
+	_ resource.ResourceWithUpgradeState = &ImportListWhisparrResource{}
 )
 
 func NewImportListWhisparrResource() resource.Resource {
@@ -37,7 +40,7 @@ func NewImportListWhisparrResource() resource.Resource {
 
 // ImportListWhisparrResource defines the import list implementation.
 type ImportListWhisparrResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // ImportListWhisparr describes the import list data model.
@@ -45,6 +48,7 @@ type ImportListWhisparr struct {
 	ProfileIds          types.Set    `tfsdk:"profile_ids"`
 	TagIds              types.Set    `tfsdk:"tag_ids"`
 	Tags                types.Set    `tfsdk:"tags"`
+	TagLabels           types.Set    `tfsdk:"tag_labels"`
 	Name                types.String `tfsdk:"name"`
 	MinimumAvailability types.String `tfsdk:"minimum_availability"`
 	RootFolderPath      types.String `tfsdk:"root_folder_path"`
@@ -64,6 +68,7 @@ func (i ImportListWhisparr) toImportList() *ImportList {
 		ProfileIds:          i.ProfileIds,
 		TagIds:              i.TagIds,
 		Tags:                i.Tags,
+		TagLabels:           i.TagLabels,
 		Name:                i.Name,
 		ShouldMonitor:       i.ShouldMonitor,
 		MinimumAvailability: i.MinimumAvailability,
@@ -86,6 +91,7 @@ func (i *ImportListWhisparr) fromImportList(importList *ImportList) {
 	i.ProfileIds = importList.ProfileIds
 	i.TagIds = importList.TagIds
 	i.Tags = importList.Tags
+	i.TagLabels = importList.TagLabels
 	i.Name = importList.Name
 	i.ShouldMonitor = importList.ShouldMonitor
 	i.MinimumAvailability = importList.MinimumAvailability
@@ -107,6 +113,7 @@ func (r *ImportListWhisparrResource) Metadata(_ context.Context, req resource.Me
 func (r *ImportListWhisparrResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->Import List Whisparr resource.\nFor more information refer to [Import List](https://wiki.servarr.com/whisparr/settings#import-lists) and [Whisparr](https://wiki.servarr.com/whisparr/supported#whisparrimport).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_auto": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
@@ -157,6 +164,15 @@ func (r *ImportListWhisparrResource) Schema(_ context.Context, _ resource.Schema
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -190,6 +206,12 @@ func (r *ImportListWhisparrResource) Schema(_ context.Context, _ resource.Schema
 	}
 }
 
+func (r *ImportListWhisparrResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *ImportListWhisparrResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -206,12 +228,18 @@ func (r *ImportListWhisparrResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new ImportListWhisparr
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListWhisparrResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, importListWhisparrResourceName, err)...)
 
 		return
 	}
@@ -219,6 +247,7 @@ func (r *ImportListWhisparrResource) Create(ctx context.Context, req resource.Cr
 	tflog.Trace(ctx, "created "+importListWhisparrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -233,9 +262,11 @@ func (r *ImportListWhisparrResource) Read(ctx context.Context, req resource.Read
 	}
 
 	// Get ImportListWhisparr current value
-	response, _, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListApi.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListWhisparrResourceName, err))
+		if !helpers.RemoveNotFound(ctx, importListWhisparrResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, importListWhisparrResourceName, err)...)
+		}
 
 		return
 	}
@@ -243,6 +274,7 @@ func (r *ImportListWhisparrResource) Read(ctx context.Context, req resource.Read
 	tflog.Trace(ctx, "read "+importListWhisparrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -256,12 +288,18 @@ func (r *ImportListWhisparrResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update ImportListWhisparr
 	request := importList.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.ImportListApi.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListWhisparrResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, importListWhisparrResourceName, err)...)
 
 		return
 	}
@@ -269,6 +307,7 @@ func (r *ImportListWhisparrResource) Update(ctx context.Context, req resource.Up
 	tflog.Trace(ctx, "updated "+importListWhisparrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
+	importList.TagLabels = r.client.TagLabels(ctx, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -284,7 +323,7 @@ func (r *ImportListWhisparrResource) Delete(ctx context.Context, req resource.De
 	// Delete ImportListWhisparr current value
 	_, err := r.client.ImportListApi.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListWhisparrResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, importListWhisparrResourceName, err)...)
 
 		return
 	}
@@ -298,6 +337,12 @@ func (r *ImportListWhisparrResource) ImportState(ctx context.Context, req resour
 	tflog.Trace(ctx, "imported "+importListWhisparrResourceName+": "+req.ID)
 }
 
+func (r *ImportListWhisparrResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewImportListResource(), "config_contract", importListWhisparrConfigContract),
+	}
+}
+
 func (i *ImportListWhisparr) write(ctx context.Context, importList *whisparr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,7 +22,7 @@ func NewImportListsDataSource() datasource.DataSource {
 
 // ImportListsDataSource defines the import lists implementation.
 type ImportListsDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // ImportLists describes the import lists data model.
@@ -103,6 +102,11 @@ func (d *ImportListsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"tag_labels": schema.SetAttribute{
+							MarkdownDescription: "List of associated tag labels.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 						"id": schema.Int64Attribute{
 							MarkdownDescription: "Import List ID.",
 							Computed:            true,
@@ -302,16 +306,19 @@ func (d *ImportListsDataSource) Read(ctx context.Context, _ datasource.ReadReque
 	// Get import lists current value
 	response, _, err := d.client.ImportListApi.ListImportList(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListsDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, importListsDataSourceName, err)...)
 
 		return
 	}
 
 	tflog.Trace(ctx, "read "+importListsDataSourceName)
+	labels := d.client.TagLabelMap(ctx, &resp.Diagnostics)
+
 	// Map response body to resource schema attribute
 	importLists := make([]ImportList, len(response))
 	for i, d := range response {
 		importLists[i].write(ctx, d, &resp.Diagnostics)
+		importLists[i].TagLabels = helpers.MapTagLabels(ctx, importLists[i].Tags, labels, &resp.Diagnostics)
 	}
 
 	listList, diags := types.SetValueFrom(ctx, ImportList{}.getType(), importLists)
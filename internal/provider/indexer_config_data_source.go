@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,7 +20,7 @@ func NewIndexerConfigDataSource() datasource.DataSource {
 
 // IndexerConfigDataSource defines the indexer config implementation.
 type IndexerConfigDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *IndexerConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -83,7 +82,7 @@ func (d *IndexerConfigDataSource) Read(ctx context.Context, _ datasource.ReadReq
 	// Get indexer config current value
 	response, _, err := d.client.IndexerConfigApi.GetIndexerConfig(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerConfigDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, indexerConfigDataSourceName, err)...)
 
 		return
 	}
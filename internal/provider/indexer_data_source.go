@@ -23,7 +23,7 @@ func NewIndexerDataSource() datasource.DataSource {
 
 // IndexerDataSource defines the indexer implementation.
 type IndexerDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *IndexerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -76,6 +76,11 @@ func (d *IndexerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Indexer ID.",
 				Computed:            true,
@@ -191,12 +196,13 @@ func (d *IndexerDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	// Get indexer current value
 	response, _, err := d.client.IndexerApi.ListIndexer(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, indexerDataSourceName, err)...)
 
 		return
 	}
 
 	data.find(ctx, data.Name.ValueString(), response, &resp.Diagnostics)
+	data.TagLabels = d.client.TagLabels(ctx, data.Tags, &resp.Diagnostics)
 	tflog.Trace(ctx, "read "+indexerDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
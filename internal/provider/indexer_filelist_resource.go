@@ -2,17 +2,18 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -26,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &IndexerFilelistResource{}
-	_ resource.ResourceWithImportState = &IndexerFilelistResource{}
+	_ resource.Resource                 = &IndexerFilelistResource{}
+	_ resource.ResourceWithImportState  = &IndexerFilelistResource{}
+	_ resource.ResourceWithMoveState    = &IndexerFilelistResource{}
+	_ resource.ResourceWithUpgradeState = &IndexerFilelistResource{}
 )
 
 func NewIndexerFilelistResource() resource.Resource {
@@ -36,7 +39,7 @@ func NewIndexerFilelistResource() resource.Resource {
 
 // IndexerFilelistResource defines the Filelist indexer implementation.
 type IndexerFilelistResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // IndexerFilelist describes the Filelist indexer data model.
@@ -46,6 +49,7 @@ type IndexerFilelist struct {
 	MultiLanguages          types.Set     `tfsdk:"multi_languages"`
 	RequiredFlags           types.Set     `tfsdk:"required_flags"`
 	Tags                    types.Set     `tfsdk:"tags"`
+	TagLabels               types.Set     `tfsdk:"tag_labels"`
 	Name                    types.String  `tfsdk:"name"`
 	BaseURL                 types.String  `tfsdk:"base_url"`
 	Username                types.String  `tfsdk:"username"`
@@ -76,6 +80,7 @@ func (i IndexerFilelist) toIndexer() *Indexer {
 		Passkey:                 i.Passkey,
 		BaseURL:                 i.BaseURL,
 		Tags:                    i.Tags,
+		TagLabels:               i.TagLabels,
 		Categories:              i.Categories,
 		MultiLanguages:          i.MultiLanguages,
 		RequiredFlags:           i.RequiredFlags,
@@ -100,6 +105,7 @@ func (i *IndexerFilelist) fromIndexer(indexer *Indexer) {
 	i.Passkey = indexer.Passkey
 	i.BaseURL = indexer.BaseURL
 	i.Tags = indexer.Tags
+	i.TagLabels = indexer.TagLabels
 	i.Categories = indexer.Categories
 	i.MultiLanguages = indexer.MultiLanguages
 	i.RequiredFlags = indexer.RequiredFlags
@@ -112,6 +118,7 @@ func (r *IndexerFilelistResource) Metadata(_ context.Context, req resource.Metad
 func (r *IndexerFilelistResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer FileList resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/whisparr/settings#indexers) and [FileList](https://wiki.servarr.com/whisparr/supported#filelist).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
@@ -148,6 +155,15 @@ func (r *IndexerFilelistResource) Schema(_ context.Context, _ resource.SchemaReq
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerFilelist ID.",
 				Computed:            true,
@@ -207,6 +223,12 @@ func (r *IndexerFilelistResource) Schema(_ context.Context, _ resource.SchemaReq
 	}
 }
 
+func (r *IndexerFilelistResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *IndexerFilelistResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -223,12 +245,18 @@ func (r *IndexerFilelistResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerFilelist
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerFilelistResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, indexerFilelistResourceName, err)...)
 
 		return
 	}
@@ -236,6 +264,7 @@ func (r *IndexerFilelistResource) Create(ctx context.Context, req resource.Creat
 	tflog.Trace(ctx, "created "+indexerFilelistResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -250,9 +279,11 @@ func (r *IndexerFilelistResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Get IndexerFilelist current value
-	response, _, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerFilelistResourceName, err))
+		if !helpers.RemoveNotFound(ctx, indexerFilelistResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, indexerFilelistResourceName, err)...)
+		}
 
 		return
 	}
@@ -260,6 +291,7 @@ func (r *IndexerFilelistResource) Read(ctx context.Context, req resource.ReadReq
 	tflog.Trace(ctx, "read "+indexerFilelistResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -273,12 +305,18 @@ func (r *IndexerFilelistResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerFilelist
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, fmt.Sprintf("Unable to update "+indexerFilelistResourceName+", got error: %s", err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, indexerFilelistResourceName, err)...)
 
 		return
 	}
@@ -286,6 +324,7 @@ func (r *IndexerFilelistResource) Update(ctx context.Context, req resource.Updat
 	tflog.Trace(ctx, "updated "+indexerFilelistResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -301,7 +340,7 @@ func (r *IndexerFilelistResource) Delete(ctx context.Context, req resource.Delet
 	// Delete IndexerFilelist current value
 	_, err := r.client.IndexerApi.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerFilelistResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, indexerFilelistResourceName, err)...)
 
 		return
 	}
@@ -315,6 +354,12 @@ func (r *IndexerFilelistResource) ImportState(ctx context.Context, req resource.
 	tflog.Trace(ctx, "imported "+indexerFilelistResourceName+": "+req.ID)
 }
 
+func (r *IndexerFilelistResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewIndexerResource(), "implementation", indexerFilelistImplementation),
+	}
+}
+
 func (i *IndexerFilelist) write(ctx context.Context, indexer *whisparr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
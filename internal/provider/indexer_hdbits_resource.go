@@ -2,17 +2,18 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -26,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &IndexerHdbitsResource{}
-	_ resource.ResourceWithImportState = &IndexerHdbitsResource{}
+	_ resource.Resource                 = &IndexerHdbitsResource{}
+	_ resource.ResourceWithImportState  = &IndexerHdbitsResource{}
+	_ resource.ResourceWithMoveState    = &IndexerHdbitsResource{}
+	_ resource.ResourceWithUpgradeState = &IndexerHdbitsResource{}
 )
 
 func NewIndexerHdbitsResource() resource.Resource {
@@ -36,13 +39,14 @@ func NewIndexerHdbitsResource() resource.Resource {
 
 // IndexerHdbitsResource defines the Hdbits indexer implementation.
 type IndexerHdbitsResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // IndexerHdbits describes the Hdbits indexer data model.
 type IndexerHdbits struct {
 	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
 	Tags                    types.Set     `tfsdk:"tags"`
+	TagLabels               types.Set     `tfsdk:"tag_labels"`
 	Categories              types.Set     `tfsdk:"categories"`
 	Mediums                 types.Set     `tfsdk:"mediums"`
 	Codecs                  types.Set     `tfsdk:"codecs"`
@@ -78,6 +82,7 @@ func (i IndexerHdbits) toIndexer() *Indexer {
 		APIKey:                  i.APIKey,
 		BaseURL:                 i.BaseURL,
 		Tags:                    i.Tags,
+		TagLabels:               i.TagLabels,
 		Categories:              i.Categories,
 		Mediums:                 i.Mediums,
 		Codecs:                  i.Codecs,
@@ -104,6 +109,7 @@ func (i *IndexerHdbits) fromIndexer(indexer *Indexer) {
 	i.APIKey = indexer.APIKey
 	i.BaseURL = indexer.BaseURL
 	i.Tags = indexer.Tags
+	i.TagLabels = indexer.TagLabels
 	i.Categories = indexer.Categories
 	i.Codecs = indexer.Codecs
 	i.Mediums = indexer.Mediums
@@ -118,6 +124,7 @@ func (r *IndexerHdbitsResource) Metadata(_ context.Context, req resource.Metadat
 func (r *IndexerHdbitsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer HDBits resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/whisparr/settings#indexers) and [HDBits](https://wiki.servarr.com/whisparr/supported#hdbits).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
@@ -154,6 +161,15 @@ func (r *IndexerHdbitsResource) Schema(_ context.Context, _ resource.SchemaReque
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerHdbits ID.",
 				Computed:            true,
@@ -225,6 +241,12 @@ func (r *IndexerHdbitsResource) Schema(_ context.Context, _ resource.SchemaReque
 	}
 }
 
+func (r *IndexerHdbitsResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *IndexerHdbitsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -241,12 +263,18 @@ func (r *IndexerHdbitsResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerHdbits
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerHdbitsResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, indexerHdbitsResourceName, err)...)
 
 		return
 	}
@@ -254,6 +282,7 @@ func (r *IndexerHdbitsResource) Create(ctx context.Context, req resource.CreateR
 	tflog.Trace(ctx, "created "+indexerHdbitsResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -268,9 +297,11 @@ func (r *IndexerHdbitsResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	// Get IndexerHdbits current value
-	response, _, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerHdbitsResourceName, err))
+		if !helpers.RemoveNotFound(ctx, indexerHdbitsResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, indexerHdbitsResourceName, err)...)
+		}
 
 		return
 	}
@@ -278,6 +309,7 @@ func (r *IndexerHdbitsResource) Read(ctx context.Context, req resource.ReadReque
 	tflog.Trace(ctx, "read "+indexerHdbitsResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -291,12 +323,18 @@ func (r *IndexerHdbitsResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerHdbits
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, fmt.Sprintf("Unable to update "+indexerHdbitsResourceName+", got error: %s", err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, indexerHdbitsResourceName, err)...)
 
 		return
 	}
@@ -304,6 +342,7 @@ func (r *IndexerHdbitsResource) Update(ctx context.Context, req resource.UpdateR
 	tflog.Trace(ctx, "updated "+indexerHdbitsResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -319,7 +358,7 @@ func (r *IndexerHdbitsResource) Delete(ctx context.Context, req resource.DeleteR
 	// Delete IndexerHdbits current value
 	_, err := r.client.IndexerApi.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerHdbitsResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, indexerHdbitsResourceName, err)...)
 
 		return
 	}
@@ -333,6 +372,12 @@ func (r *IndexerHdbitsResource) ImportState(ctx context.Context, req resource.Im
 	tflog.Trace(ctx, "imported "+indexerHdbitsResourceName+": "+req.ID)
 }
 
+func (r *IndexerHdbitsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewIndexerResource(), "implementation", indexerHdbitsImplementation),
+	}
+}
+
 func (i *IndexerHdbits) write(ctx context.Context, indexer *whisparr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
@@ -2,17 +2,18 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -26,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &IndexerIptorrentsResource{}
-	_ resource.ResourceWithImportState = &IndexerIptorrentsResource{}
+	_ resource.Resource                 = &IndexerIptorrentsResource{}
+	_ resource.ResourceWithImportState  = &IndexerIptorrentsResource{}
+	_ resource.ResourceWithMoveState    = &IndexerIptorrentsResource{}
+	_ resource.ResourceWithUpgradeState = &IndexerIptorrentsResource{}
 )
 
 func NewIndexerIptorrentsResource() resource.Resource {
@@ -36,13 +39,14 @@ func NewIndexerIptorrentsResource() resource.Resource {
 
 // IndexerIptorrentsResource defines the Iptorrents indexer implementation.
 type IndexerIptorrentsResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // IndexerIptorrents describes the Iptorrents indexer data model.
 type IndexerIptorrents struct {
 	SeedRatio        types.Float64 `tfsdk:"seed_ratio"`
 	Tags             types.Set     `tfsdk:"tags"`
+	TagLabels        types.Set     `tfsdk:"tag_labels"`
 	MultiLanguages   types.Set     `tfsdk:"multi_languages"`
 	RequiredFlags    types.Set     `tfsdk:"required_flags"`
 	Name             types.String  `tfsdk:"name"`
@@ -67,6 +71,7 @@ func (i IndexerIptorrents) toIndexer() *Indexer {
 		SeedRatio:        i.SeedRatio,
 		BaseURL:          i.BaseURL,
 		Tags:             i.Tags,
+		TagLabels:        i.TagLabels,
 		MultiLanguages:   i.MultiLanguages,
 		RequiredFlags:    i.RequiredFlags,
 		Implementation:   types.StringValue(indexerIptorrentsImplementation),
@@ -86,6 +91,7 @@ func (i *IndexerIptorrents) fromIndexer(indexer *Indexer) {
 	i.SeedRatio = indexer.SeedRatio
 	i.BaseURL = indexer.BaseURL
 	i.Tags = indexer.Tags
+	i.TagLabels = indexer.TagLabels
 	i.MultiLanguages = indexer.MultiLanguages
 	i.RequiredFlags = indexer.RequiredFlags
 }
@@ -97,6 +103,7 @@ func (r *IndexerIptorrentsResource) Metadata(_ context.Context, req resource.Met
 func (r *IndexerIptorrentsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer IP Torrents resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/whisparr/settings#indexers) and [IP Torrents](https://wiki.servarr.com/whisparr/supported#iptorrents).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_rss": schema.BoolAttribute{
 				MarkdownDescription: "Enable RSS flag.",
@@ -123,6 +130,15 @@ func (r *IndexerIptorrentsResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerIptorrents ID.",
 				Computed:            true,
@@ -166,6 +182,12 @@ func (r *IndexerIptorrentsResource) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
+func (r *IndexerIptorrentsResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *IndexerIptorrentsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -182,12 +204,18 @@ func (r *IndexerIptorrentsResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerIptorrents
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerIptorrentsResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, indexerIptorrentsResourceName, err)...)
 
 		return
 	}
@@ -195,6 +223,7 @@ func (r *IndexerIptorrentsResource) Create(ctx context.Context, req resource.Cre
 	tflog.Trace(ctx, "created "+indexerIptorrentsResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -209,9 +238,11 @@ func (r *IndexerIptorrentsResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get IndexerIptorrents current value
-	response, _, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerIptorrentsResourceName, err))
+		if !helpers.RemoveNotFound(ctx, indexerIptorrentsResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, indexerIptorrentsResourceName, err)...)
+		}
 
 		return
 	}
@@ -219,6 +250,7 @@ func (r *IndexerIptorrentsResource) Read(ctx context.Context, req resource.ReadR
 	tflog.Trace(ctx, "read "+indexerIptorrentsResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -232,12 +264,18 @@ func (r *IndexerIptorrentsResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerIptorrents
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, fmt.Sprintf("Unable to update "+indexerIptorrentsResourceName+", got error: %s", err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, indexerIptorrentsResourceName, err)...)
 
 		return
 	}
@@ -245,6 +283,7 @@ func (r *IndexerIptorrentsResource) Update(ctx context.Context, req resource.Upd
 	tflog.Trace(ctx, "updated "+indexerIptorrentsResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -260,7 +299,7 @@ func (r *IndexerIptorrentsResource) Delete(ctx context.Context, req resource.Del
 	// Delete IndexerIptorrents current value
 	_, err := r.client.IndexerApi.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerIptorrentsResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, indexerIptorrentsResourceName, err)...)
 
 		return
 	}
@@ -274,6 +313,12 @@ func (r *IndexerIptorrentsResource) ImportState(ctx context.Context, req resourc
 	tflog.Trace(ctx, "imported "+indexerIptorrentsResourceName+": "+req.ID)
 }
 
+func (r *IndexerIptorrentsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewIndexerResource(), "implementation", indexerIptorrentsImplementation),
+	}
+}
+
 func (i *IndexerIptorrents) write(ctx context.Context, indexer *whisparr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
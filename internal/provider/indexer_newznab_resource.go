@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -25,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &IndexerNewznabResource{}
-	_ resource.ResourceWithImportState = &IndexerNewznabResource{}
+	_ resource.Resource                 = &IndexerNewznabResource{}
+	_ resource.ResourceWithImportState  = &IndexerNewznabResource{}
+	_ resource.ResourceWithMoveState    = &IndexerNewznabResource{}
+	_ resource.ResourceWithUpgradeState = &IndexerNewznabResource{}
 )
 
 func NewIndexerNewznabResource() resource.Resource {
@@ -35,12 +39,13 @@ func NewIndexerNewznabResource() resource.Resource {
 
 // IndexerNewznabResource defines the Newznab indexer implementation.
 type IndexerNewznabResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // IndexerNewznab describes the Newznab indexer data model.
 type IndexerNewznab struct {
 	Tags                    types.Set    `tfsdk:"tags"`
+	TagLabels               types.Set    `tfsdk:"tag_labels"`
 	Categories              types.Set    `tfsdk:"categories"`
 	MultiLanguages          types.Set    `tfsdk:"multi_languages"`
 	AdditionalParameters    types.String `tfsdk:"additional_parameters"`
@@ -72,6 +77,7 @@ func (i IndexerNewznab) toIndexer() *Indexer {
 		MultiLanguages:          i.MultiLanguages,
 		Categories:              i.Categories,
 		Tags:                    i.Tags,
+		TagLabels:               i.TagLabels,
 		Implementation:          types.StringValue(indexerNewznabImplementation),
 		ConfigContract:          types.StringValue(indexerNewznabConfigContract),
 		Protocol:                types.StringValue(indexerNewznabProtocol),
@@ -93,6 +99,7 @@ func (i *IndexerNewznab) fromIndexer(indexer *Indexer) {
 	i.MultiLanguages = indexer.MultiLanguages
 	i.Categories = indexer.Categories
 	i.Tags = indexer.Tags
+	i.TagLabels = indexer.TagLabels
 }
 
 func (r *IndexerNewznabResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -102,6 +109,7 @@ func (r *IndexerNewznabResource) Metadata(_ context.Context, req resource.Metada
 func (r *IndexerNewznabResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer Newznab resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/whisparr/settings#indexers) and [Newznab](https://wiki.servarr.com/whisparr/supported#newznab).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
@@ -138,6 +146,15 @@ func (r *IndexerNewznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerNewznab ID.",
 				Computed:            true,
@@ -182,6 +199,12 @@ func (r *IndexerNewznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 	}
 }
 
+func (r *IndexerNewznabResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *IndexerNewznabResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -198,12 +221,18 @@ func (r *IndexerNewznabResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerNewznab
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerNewznabResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, indexerNewznabResourceName, err)...)
 
 		return
 	}
@@ -211,6 +240,7 @@ func (r *IndexerNewznabResource) Create(ctx context.Context, req resource.Create
 	tflog.Trace(ctx, "created "+indexerNewznabResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -225,9 +255,11 @@ func (r *IndexerNewznabResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get IndexerNewznab current value
-	response, _, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerNewznabResourceName, err))
+		if !helpers.RemoveNotFound(ctx, indexerNewznabResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, indexerNewznabResourceName, err)...)
+		}
 
 		return
 	}
@@ -235,6 +267,7 @@ func (r *IndexerNewznabResource) Read(ctx context.Context, req resource.ReadRequ
 	tflog.Trace(ctx, "read "+indexerNewznabResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -248,12 +281,18 @@ func (r *IndexerNewznabResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerNewznab
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerNewznabResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, indexerNewznabResourceName, err)...)
 
 		return
 	}
@@ -261,6 +300,7 @@ func (r *IndexerNewznabResource) Update(ctx context.Context, req resource.Update
 	tflog.Trace(ctx, "updated "+indexerNewznabResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -276,7 +316,7 @@ func (r *IndexerNewznabResource) Delete(ctx context.Context, req resource.Delete
 	// Delete IndexerNewznab current value
 	_, err := r.client.IndexerApi.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerNewznabResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, indexerNewznabResourceName, err)...)
 
 		return
 	}
@@ -290,6 +330,12 @@ func (r *IndexerNewznabResource) ImportState(ctx context.Context, req resource.I
 	tflog.Trace(ctx, "imported "+indexerNewznabResourceName+": "+req.ID)
 }
 
+func (r *IndexerNewznabResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewIndexerResource(), "implementation", indexerNewznabImplementation),
+	}
+}
+
 func (i *IndexerNewznab) write(ctx context.Context, indexer *whisparr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
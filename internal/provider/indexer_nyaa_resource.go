@@ -2,17 +2,18 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -26,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &IndexerNyaaResource{}
-	_ resource.ResourceWithImportState = &IndexerNyaaResource{}
+	_ resource.Resource                 = &IndexerNyaaResource{}
+	_ resource.ResourceWithImportState  = &IndexerNyaaResource{}
+	_ resource.ResourceWithMoveState    = &IndexerNyaaResource{}
+	_ resource.ResourceWithUpgradeState = &IndexerNyaaResource{}
 )
 
 func NewIndexerNyaaResource() resource.Resource {
@@ -36,7 +39,7 @@ func NewIndexerNyaaResource() resource.Resource {
 
 // IndexerNyaaResource defines the Nyaa indexer implementation.
 type IndexerNyaaResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // IndexerNyaa describes the Nyaa indexer data model.
@@ -45,6 +48,7 @@ type IndexerNyaa struct {
 	MultiLanguages          types.Set     `tfsdk:"multi_languages"`
 	RequiredFlags           types.Set     `tfsdk:"required_flags"`
 	Tags                    types.Set     `tfsdk:"tags"`
+	TagLabels               types.Set     `tfsdk:"tag_labels"`
 	Name                    types.String  `tfsdk:"name"`
 	BaseURL                 types.String  `tfsdk:"base_url"`
 	AdditionalParameters    types.String  `tfsdk:"additional_parameters"`
@@ -73,6 +77,7 @@ func (i IndexerNyaa) toIndexer() *Indexer {
 		SeedRatio:               i.SeedRatio,
 		BaseURL:                 i.BaseURL,
 		Tags:                    i.Tags,
+		TagLabels:               i.TagLabels,
 		MultiLanguages:          i.MultiLanguages,
 		RequiredFlags:           i.RequiredFlags,
 		Implementation:          types.StringValue(indexerNyaaImplementation),
@@ -95,6 +100,7 @@ func (i *IndexerNyaa) fromIndexer(indexer *Indexer) {
 	i.SeedRatio = indexer.SeedRatio
 	i.BaseURL = indexer.BaseURL
 	i.Tags = indexer.Tags
+	i.TagLabels = indexer.TagLabels
 	i.MultiLanguages = indexer.MultiLanguages
 	i.RequiredFlags = indexer.RequiredFlags
 }
@@ -106,6 +112,7 @@ func (r *IndexerNyaaResource) Metadata(_ context.Context, req resource.MetadataR
 func (r *IndexerNyaaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer Nyaa resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/whisparr/settings#indexers) and [Nyaa](https://wiki.servarr.com/whisparr/supported#nyaa).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
@@ -142,6 +149,15 @@ func (r *IndexerNyaaResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerNyaa ID.",
 				Computed:            true,
@@ -190,6 +206,12 @@ func (r *IndexerNyaaResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (r *IndexerNyaaResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *IndexerNyaaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -206,12 +228,18 @@ func (r *IndexerNyaaResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerNyaa
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerNyaaResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, indexerNyaaResourceName, err)...)
 
 		return
 	}
@@ -219,6 +247,7 @@ func (r *IndexerNyaaResource) Create(ctx context.Context, req resource.CreateReq
 	tflog.Trace(ctx, "created "+indexerNyaaResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -233,9 +262,11 @@ func (r *IndexerNyaaResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Get IndexerNyaa current value
-	response, _, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerNyaaResourceName, err))
+		if !helpers.RemoveNotFound(ctx, indexerNyaaResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, indexerNyaaResourceName, err)...)
+		}
 
 		return
 	}
@@ -243,6 +274,7 @@ func (r *IndexerNyaaResource) Read(ctx context.Context, req resource.ReadRequest
 	tflog.Trace(ctx, "read "+indexerNyaaResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -256,12 +288,18 @@ func (r *IndexerNyaaResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerNyaa
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, fmt.Sprintf("Unable to update "+indexerNyaaResourceName+", got error: %s", err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, indexerNyaaResourceName, err)...)
 
 		return
 	}
@@ -269,6 +307,7 @@ func (r *IndexerNyaaResource) Update(ctx context.Context, req resource.UpdateReq
 	tflog.Trace(ctx, "updated "+indexerNyaaResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -284,7 +323,7 @@ func (r *IndexerNyaaResource) Delete(ctx context.Context, req resource.DeleteReq
 	// Delete IndexerNyaa current value
 	_, err := r.client.IndexerApi.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerNyaaResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, indexerNyaaResourceName, err)...)
 
 		return
 	}
@@ -298,6 +337,12 @@ func (r *IndexerNyaaResource) ImportState(ctx context.Context, req resource.Impo
 	tflog.Trace(ctx, "imported "+indexerNyaaResourceName+": "+req.ID)
 }
 
+func (r *IndexerNyaaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewIndexerResource(), "implementation", indexerNyaaImplementation),
+	}
+}
+
 func (i *IndexerNyaa) write(ctx context.Context, indexer *whisparr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -25,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &IndexerOmgwtfnzbsResource{}
-	_ resource.ResourceWithImportState = &IndexerOmgwtfnzbsResource{}
+	_ resource.Resource                 = &IndexerOmgwtfnzbsResource{}
+	_ resource.ResourceWithImportState  = &IndexerOmgwtfnzbsResource{}
+	_ resource.ResourceWithMoveState    = &IndexerOmgwtfnzbsResource{}
+	_ resource.ResourceWithUpgradeState = &IndexerOmgwtfnzbsResource{}
 )
 
 func NewIndexerOmgwtfnzbsResource() resource.Resource {
@@ -35,12 +39,13 @@ func NewIndexerOmgwtfnzbsResource() resource.Resource {
 
 // IndexerOmgwtfnzbsResource defines the Omgwtfnzbs indexer implementation.
 type IndexerOmgwtfnzbsResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // IndexerOmgwtfnzbs describes the Omgwtfnzbs indexer data model.
 type IndexerOmgwtfnzbs struct {
 	Tags                    types.Set    `tfsdk:"tags"`
+	TagLabels               types.Set    `tfsdk:"tag_labels"`
 	MultiLanguages          types.Set    `tfsdk:"multi_languages"`
 	APIKey                  types.String `tfsdk:"api_key"`
 	Name                    types.String `tfsdk:"name"`
@@ -67,6 +72,7 @@ func (i IndexerOmgwtfnzbs) toIndexer() *Indexer {
 		Username:                i.Username,
 		APIKey:                  i.APIKey,
 		Tags:                    i.Tags,
+		TagLabels:               i.TagLabels,
 		MultiLanguages:          i.MultiLanguages,
 		Implementation:          types.StringValue(indexerOmgwtfnzbsImplementation),
 		ConfigContract:          types.StringValue(indexerOmgwtfnzbsConfigContract),
@@ -86,6 +92,7 @@ func (i *IndexerOmgwtfnzbs) fromIndexer(indexer *Indexer) {
 	i.Username = indexer.Username
 	i.APIKey = indexer.APIKey
 	i.Tags = indexer.Tags
+	i.TagLabels = indexer.TagLabels
 	i.MultiLanguages = indexer.MultiLanguages
 }
 
@@ -96,6 +103,7 @@ func (r *IndexerOmgwtfnzbsResource) Metadata(_ context.Context, req resource.Met
 func (r *IndexerOmgwtfnzbsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer Omgwtfnzbs resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/whisparr/settings#indexers) and [Omgwtfnzbs](https://wiki.servarr.com/whisparr/supported#omgwtfnzbs).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
@@ -132,6 +140,15 @@ func (r *IndexerOmgwtfnzbsResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerOmgwtfnzbs ID.",
 				Computed:            true,
@@ -164,6 +181,12 @@ func (r *IndexerOmgwtfnzbsResource) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
+func (r *IndexerOmgwtfnzbsResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *IndexerOmgwtfnzbsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -180,12 +203,18 @@ func (r *IndexerOmgwtfnzbsResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerOmgwtfnzbs
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerOmgwtfnzbsResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, indexerOmgwtfnzbsResourceName, err)...)
 
 		return
 	}
@@ -193,6 +222,7 @@ func (r *IndexerOmgwtfnzbsResource) Create(ctx context.Context, req resource.Cre
 	tflog.Trace(ctx, "created "+indexerOmgwtfnzbsResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -207,9 +237,11 @@ func (r *IndexerOmgwtfnzbsResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get IndexerOmgwtfnzbs current value
-	response, _, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerOmgwtfnzbsResourceName, err))
+		if !helpers.RemoveNotFound(ctx, indexerOmgwtfnzbsResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, indexerOmgwtfnzbsResourceName, err)...)
+		}
 
 		return
 	}
@@ -217,6 +249,7 @@ func (r *IndexerOmgwtfnzbsResource) Read(ctx context.Context, req resource.ReadR
 	tflog.Trace(ctx, "read "+indexerOmgwtfnzbsResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -230,12 +263,18 @@ func (r *IndexerOmgwtfnzbsResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerOmgwtfnzbs
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerOmgwtfnzbsResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, indexerOmgwtfnzbsResourceName, err)...)
 
 		return
 	}
@@ -243,6 +282,7 @@ func (r *IndexerOmgwtfnzbsResource) Update(ctx context.Context, req resource.Upd
 	tflog.Trace(ctx, "updated "+indexerOmgwtfnzbsResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -258,7 +298,7 @@ func (r *IndexerOmgwtfnzbsResource) Delete(ctx context.Context, req resource.Del
 	// Delete IndexerOmgwtfnzbs current value
 	_, err := r.client.IndexerApi.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerOmgwtfnzbsResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, indexerOmgwtfnzbsResourceName, err)...)
 
 		return
 	}
@@ -272,6 +312,12 @@ func (r *IndexerOmgwtfnzbsResource) ImportState(ctx context.Context, req resourc
 	tflog.Trace(ctx, "imported "+indexerOmgwtfnzbsResourceName+": "+req.ID)
 }
 
+func (r *IndexerOmgwtfnzbsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewIndexerResource(), "implementation", indexerOmgwtfnzbsImplementation),
+	}
+}
+
 func (i *IndexerOmgwtfnzbs) write(ctx context.Context, indexer *whisparr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
@@ -2,17 +2,18 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -26,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &IndexerRarbgResource{}
-	_ resource.ResourceWithImportState = &IndexerRarbgResource{}
+	_ resource.Resource                 = &IndexerRarbgResource{}
+	_ resource.ResourceWithImportState  = &IndexerRarbgResource{}
+	_ resource.ResourceWithMoveState    = &IndexerRarbgResource{}
+	_ resource.ResourceWithUpgradeState = &IndexerRarbgResource{}
 )
 
 func NewIndexerRarbgResource() resource.Resource {
@@ -36,7 +39,7 @@ func NewIndexerRarbgResource() resource.Resource {
 
 // IndexerRarbgResource defines the Rarbg indexer implementation.
 type IndexerRarbgResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // IndexerRarbg describes the Rarbg indexer data model.
@@ -46,6 +49,7 @@ type IndexerRarbg struct {
 	RequiredFlags           types.Set     `tfsdk:"required_flags"`
 	Categories              types.Set     `tfsdk:"categories"`
 	Tags                    types.Set     `tfsdk:"tags"`
+	TagLabels               types.Set     `tfsdk:"tag_labels"`
 	Name                    types.String  `tfsdk:"name"`
 	BaseURL                 types.String  `tfsdk:"base_url"`
 	CaptchaToken            types.String  `tfsdk:"captcha_token"`
@@ -76,6 +80,7 @@ func (i IndexerRarbg) toIndexer() *Indexer {
 		CaptchaToken:            i.CaptchaToken,
 		BaseURL:                 i.BaseURL,
 		Tags:                    i.Tags,
+		TagLabels:               i.TagLabels,
 		MultiLanguages:          i.MultiLanguages,
 		RequiredFlags:           i.RequiredFlags,
 		Categories:              i.Categories,
@@ -100,6 +105,7 @@ func (i *IndexerRarbg) fromIndexer(indexer *Indexer) {
 	i.CaptchaToken = indexer.CaptchaToken
 	i.BaseURL = indexer.BaseURL
 	i.Tags = indexer.Tags
+	i.TagLabels = indexer.TagLabels
 	i.MultiLanguages = indexer.MultiLanguages
 	i.RequiredFlags = indexer.RequiredFlags
 	i.Categories = indexer.Categories
@@ -112,6 +118,7 @@ func (r *IndexerRarbgResource) Metadata(_ context.Context, req resource.Metadata
 func (r *IndexerRarbgResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer Rarbg resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/whisparr/settings#indexers) and [Rarbg](https://wiki.servarr.com/whisparr/supported#rarbg).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
@@ -148,6 +155,15 @@ func (r *IndexerRarbgResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerRarbg ID.",
 				Computed:            true,
@@ -208,6 +224,12 @@ func (r *IndexerRarbgResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func (r *IndexerRarbgResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *IndexerRarbgResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -224,12 +246,18 @@ func (r *IndexerRarbgResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerRarbg
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerRarbgResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, indexerRarbgResourceName, err)...)
 
 		return
 	}
@@ -237,6 +265,7 @@ func (r *IndexerRarbgResource) Create(ctx context.Context, req resource.CreateRe
 	tflog.Trace(ctx, "created "+indexerRarbgResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -251,9 +280,11 @@ func (r *IndexerRarbgResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get IndexerRarbg current value
-	response, _, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerRarbgResourceName, err))
+		if !helpers.RemoveNotFound(ctx, indexerRarbgResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, indexerRarbgResourceName, err)...)
+		}
 
 		return
 	}
@@ -261,6 +292,7 @@ func (r *IndexerRarbgResource) Read(ctx context.Context, req resource.ReadReques
 	tflog.Trace(ctx, "read "+indexerRarbgResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -274,12 +306,18 @@ func (r *IndexerRarbgResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerRarbg
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, fmt.Sprintf("Unable to update "+indexerRarbgResourceName+", got error: %s", err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, indexerRarbgResourceName, err)...)
 
 		return
 	}
@@ -287,6 +325,7 @@ func (r *IndexerRarbgResource) Update(ctx context.Context, req resource.UpdateRe
 	tflog.Trace(ctx, "updated "+indexerRarbgResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -302,7 +341,7 @@ func (r *IndexerRarbgResource) Delete(ctx context.Context, req resource.DeleteRe
 	// Delete IndexerRarbg current value
 	_, err := r.client.IndexerApi.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerRarbgResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, indexerRarbgResourceName, err)...)
 
 		return
 	}
@@ -316,6 +355,12 @@ func (r *IndexerRarbgResource) ImportState(ctx context.Context, req resource.Imp
 	tflog.Trace(ctx, "imported "+indexerRarbgResourceName+": "+req.ID)
 }
 
+func (r *IndexerRarbgResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewIndexerResource(), "implementation", indexerRarbgImplementation),
+	}
+}
+
 func (i *IndexerRarbg) write(ctx context.Context, indexer *whisparr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
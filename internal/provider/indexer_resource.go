@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -23,14 +24,16 @@ const indexerResourceName = "indexer"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &IndexerResource{}
-	_ resource.ResourceWithImportState = &IndexerResource{}
+	_ resource.Resource                     = &IndexerResource{}
+	_ resource.ResourceWithImportState      = &IndexerResource{}
+	_ resource.ResourceWithConfigValidators = &IndexerResource{}
+	_ resource.ResourceWithUpgradeState     = &IndexerResource{}
 )
 
 var indexerFields = helpers.Fields{
 	Bools:            []string{"allowZeroSize", "rankedOnly"},
 	Ints:             []string{"delay", "minimumSeeders", "seedTime"},
-	IntsExceptions:   []string{"seedCriteria.seedTime", "seedCriteria.seasonPackSeedTime"},
+	IntsExceptions:   []string{"seedCriteria.seedTime"},
 	Strings:          []string{"additionalParameters", "apiKey", "apiPath", "baseUrl", "captchaToken", "cookie", "passkey", "username", "user", "aPIUser", "aPIKey"},
 	Floats:           []string{"seedRatio"},
 	FloatsExceptions: []string{"seedCriteria.seedRatio"},
@@ -43,7 +46,7 @@ func NewIndexerResource() resource.Resource {
 
 // IndexerResource defines the indexer implementation.
 type IndexerResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // Indexer describes the indexer data model.
@@ -54,6 +57,7 @@ type Indexer struct {
 	Codecs                  types.Set     `tfsdk:"codecs"`
 	RequiredFlags           types.Set     `tfsdk:"required_flags"`
 	Tags                    types.Set     `tfsdk:"tags"`
+	TagLabels               types.Set     `tfsdk:"tag_labels"`
 	MultiLanguages          types.Set     `tfsdk:"multi_languages"`
 	AdditionalParameters    types.String  `tfsdk:"additional_parameters"`
 	Cookie                  types.String  `tfsdk:"cookie"`
@@ -86,6 +90,7 @@ func (i Indexer) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
 			"tags":                      types.SetType{}.WithElementType(types.Int64Type),
+			"tag_labels":                types.SetType{}.WithElementType(types.StringType),
 			"categories":                types.SetType{}.WithElementType(types.Int64Type),
 			"mediums":                   types.SetType{}.WithElementType(types.Int64Type),
 			"codecs":                    types.SetType{}.WithElementType(types.Int64Type),
@@ -126,7 +131,8 @@ func (r *IndexerResource) Metadata(_ context.Context, req resource.MetadataReque
 
 func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "<!-- subcategory:Indexers -->Generic Indexer resource. When possible use a specific resource instead.\nFor more information refer to [Indexer](https://wiki.servarr.com/whisparr/settings#indexers) documentation.",
+		MarkdownDescription: "<!-- subcategory:Indexers -->Generic Indexer resource. When possible use a specific resource instead, existing ones can be moved there with a `moved` block (Terraform 1.8+).\nFor more information refer to [Indexer](https://wiki.servarr.com/whisparr/settings#indexers) documentation.",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
@@ -178,6 +184,15 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Indexer ID.",
 				Computed:            true,
@@ -300,12 +315,40 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	}
 }
 
+func (r *IndexerResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *IndexerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 	}
 }
 
+func (r *IndexerResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		helpers.ImplementationValidator{
+			Resources: map[string]func() resource.Resource{
+				indexerFilelistImplementation:      NewIndexerFilelistResource,
+				indexerHdbitsImplementation:        NewIndexerHdbitsResource,
+				indexerIptorrentsImplementation:    NewIndexerIptorrentsResource,
+				indexerNewznabImplementation:       NewIndexerNewznabResource,
+				indexerNyaaImplementation:          NewIndexerNyaaResource,
+				indexerOmgwtfnzbsImplementation:    NewIndexerOmgwtfnzbsResource,
+				indexerRarbgImplementation:         NewIndexerRarbgResource,
+				indexerTorrentPotatoImplementation: NewIndexerTorrentPotatoResource,
+				indexerTorrentRssImplementation:    NewIndexerTorrentRssResource,
+				indexerTorznabImplementation:       NewIndexerTorznabResource,
+			},
+			Attribute: "implementation",
+			Model:     &Indexer{},
+			Fields:    indexerFields,
+		},
+	}
+}
+
 func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *Indexer
@@ -316,12 +359,18 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new Indexer
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, indexerResourceName, err)...)
 
 		return
 	}
@@ -332,6 +381,7 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 	var state Indexer
 
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = r.client.TagLabels(ctx, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -346,9 +396,11 @@ func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Get Indexer current value
-	response, _, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerResourceName, err))
+		if !helpers.RemoveNotFound(ctx, indexerResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, indexerResourceName, err)...)
+		}
 
 		return
 	}
@@ -359,6 +411,7 @@ func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, re
 	var state Indexer
 
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = r.client.TagLabels(ctx, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -372,12 +425,18 @@ func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update Indexer
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, indexerResourceName, err)...)
 
 		return
 	}
@@ -388,6 +447,7 @@ func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest
 	var state Indexer
 
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = r.client.TagLabels(ctx, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -403,7 +463,7 @@ func (r *IndexerResource) Delete(ctx context.Context, req resource.DeleteRequest
 	// Delete Indexer current value
 	_, err := r.client.IndexerApi.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, indexerResourceName, err)...)
 
 		return
 	}
@@ -2,17 +2,18 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -26,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &IndexerTorrentPotatoResource{}
-	_ resource.ResourceWithImportState = &IndexerTorrentPotatoResource{}
+	_ resource.Resource                 = &IndexerTorrentPotatoResource{}
+	_ resource.ResourceWithImportState  = &IndexerTorrentPotatoResource{}
+	_ resource.ResourceWithMoveState    = &IndexerTorrentPotatoResource{}
+	_ resource.ResourceWithUpgradeState = &IndexerTorrentPotatoResource{}
 )
 
 func NewIndexerTorrentPotatoResource() resource.Resource {
@@ -36,13 +39,14 @@ func NewIndexerTorrentPotatoResource() resource.Resource {
 
 // IndexerTorrentPotatoResource defines the TorrentPotato indexer implementation.
 type IndexerTorrentPotatoResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // IndexerTorrentPotato describes the TorrentPotato indexer data model.
 type IndexerTorrentPotato struct {
 	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
 	Tags                    types.Set     `tfsdk:"tags"`
+	TagLabels               types.Set     `tfsdk:"tag_labels"`
 	MultiLanguages          types.Set     `tfsdk:"multi_languages"`
 	RequiredFlags           types.Set     `tfsdk:"required_flags"`
 	BaseURL                 types.String  `tfsdk:"base_url"`
@@ -75,6 +79,7 @@ func (i IndexerTorrentPotato) toIndexer() *Indexer {
 		User:                    i.User,
 		Passkey:                 i.Passkey,
 		Tags:                    i.Tags,
+		TagLabels:               i.TagLabels,
 		MultiLanguages:          i.MultiLanguages,
 		RequiredFlags:           i.RequiredFlags,
 		Implementation:          types.StringValue(indexerTorrentPotatoImplementation),
@@ -98,6 +103,7 @@ func (i *IndexerTorrentPotato) fromIndexer(indexer *Indexer) {
 	i.SeedRatio = indexer.SeedRatio
 	i.BaseURL = indexer.BaseURL
 	i.Tags = indexer.Tags
+	i.TagLabels = indexer.TagLabels
 	i.MultiLanguages = indexer.MultiLanguages
 	i.RequiredFlags = indexer.RequiredFlags
 }
@@ -109,6 +115,7 @@ func (r *IndexerTorrentPotatoResource) Metadata(_ context.Context, req resource.
 func (r *IndexerTorrentPotatoResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer TorrentPotato resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/whisparr/settings#indexers) and [TorrentPotato](https://wiki.servarr.com/whisparr/supported#torrentpotato).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
@@ -145,6 +152,15 @@ func (r *IndexerTorrentPotatoResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerTorrentPotato ID.",
 				Computed:            true,
@@ -199,6 +215,12 @@ func (r *IndexerTorrentPotatoResource) Schema(_ context.Context, _ resource.Sche
 	}
 }
 
+func (r *IndexerTorrentPotatoResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *IndexerTorrentPotatoResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -215,12 +237,18 @@ func (r *IndexerTorrentPotatoResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerTorrentPotato
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerTorrentPotatoResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, indexerTorrentPotatoResourceName, err)...)
 
 		return
 	}
@@ -228,6 +256,7 @@ func (r *IndexerTorrentPotatoResource) Create(ctx context.Context, req resource.
 	tflog.Trace(ctx, "created "+indexerTorrentPotatoResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -242,9 +271,11 @@ func (r *IndexerTorrentPotatoResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get IndexerTorrentPotato current value
-	response, _, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerTorrentPotatoResourceName, err))
+		if !helpers.RemoveNotFound(ctx, indexerTorrentPotatoResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, indexerTorrentPotatoResourceName, err)...)
+		}
 
 		return
 	}
@@ -252,6 +283,7 @@ func (r *IndexerTorrentPotatoResource) Read(ctx context.Context, req resource.Re
 	tflog.Trace(ctx, "read "+indexerTorrentPotatoResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -265,12 +297,18 @@ func (r *IndexerTorrentPotatoResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerTorrentPotato
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, fmt.Sprintf("Unable to update "+indexerTorrentPotatoResourceName+", got error: %s", err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, indexerTorrentPotatoResourceName, err)...)
 
 		return
 	}
@@ -278,6 +316,7 @@ func (r *IndexerTorrentPotatoResource) Update(ctx context.Context, req resource.
 	tflog.Trace(ctx, "updated "+indexerTorrentPotatoResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -293,7 +332,7 @@ func (r *IndexerTorrentPotatoResource) Delete(ctx context.Context, req resource.
 	// Delete IndexerTorrentPotato current value
 	_, err := r.client.IndexerApi.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerTorrentPotatoResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, indexerTorrentPotatoResourceName, err)...)
 
 		return
 	}
@@ -307,6 +346,12 @@ func (r *IndexerTorrentPotatoResource) ImportState(ctx context.Context, req reso
 	tflog.Trace(ctx, "imported "+indexerTorrentPotatoResourceName+": "+req.ID)
 }
 
+func (r *IndexerTorrentPotatoResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewIndexerResource(), "implementation", indexerTorrentPotatoImplementation),
+	}
+}
+
 func (i *IndexerTorrentPotato) write(ctx context.Context, indexer *whisparr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
@@ -2,17 +2,18 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -26,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &IndexerTorrentRssResource{}
-	_ resource.ResourceWithImportState = &IndexerTorrentRssResource{}
+	_ resource.Resource                 = &IndexerTorrentRssResource{}
+	_ resource.ResourceWithImportState  = &IndexerTorrentRssResource{}
+	_ resource.ResourceWithMoveState    = &IndexerTorrentRssResource{}
+	_ resource.ResourceWithUpgradeState = &IndexerTorrentRssResource{}
 )
 
 func NewIndexerTorrentRssResource() resource.Resource {
@@ -36,7 +39,7 @@ func NewIndexerTorrentRssResource() resource.Resource {
 
 // IndexerTorrentRssResource defines the TorrentRss indexer implementation.
 type IndexerTorrentRssResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // IndexerTorrentRss describes the TorrentRss indexer data model.
@@ -45,6 +48,7 @@ type IndexerTorrentRss struct {
 	MultiLanguages   types.Set     `tfsdk:"multi_languages"`
 	RequiredFlags    types.Set     `tfsdk:"required_flags"`
 	Tags             types.Set     `tfsdk:"tags"`
+	TagLabels        types.Set     `tfsdk:"tag_labels"`
 	Name             types.String  `tfsdk:"name"`
 	BaseURL          types.String  `tfsdk:"base_url"`
 	Cookie           types.String  `tfsdk:"cookie"`
@@ -71,6 +75,7 @@ func (i IndexerTorrentRss) toIndexer() *Indexer {
 		SeedRatio:        i.SeedRatio,
 		BaseURL:          i.BaseURL,
 		Tags:             i.Tags,
+		TagLabels:        i.TagLabels,
 		MultiLanguages:   i.MultiLanguages,
 		RequiredFlags:    i.RequiredFlags,
 		Implementation:   types.StringValue(indexerTorrentRssImplementation),
@@ -92,6 +97,7 @@ func (i *IndexerTorrentRss) fromIndexer(indexer *Indexer) {
 	i.SeedRatio = indexer.SeedRatio
 	i.BaseURL = indexer.BaseURL
 	i.Tags = indexer.Tags
+	i.TagLabels = indexer.TagLabels
 	i.MultiLanguages = indexer.MultiLanguages
 	i.RequiredFlags = indexer.RequiredFlags
 }
@@ -103,6 +109,7 @@ func (r *IndexerTorrentRssResource) Metadata(_ context.Context, req resource.Met
 func (r *IndexerTorrentRssResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer Torrent RSS resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/whisparr/settings#indexers) and [Torrent RSS](https://wiki.servarr.com/whisparr/supported#torrentrssindexer).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_rss": schema.BoolAttribute{
 				MarkdownDescription: "Enable RSS flag.",
@@ -129,6 +136,15 @@ func (r *IndexerTorrentRssResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerTorrentRss ID.",
 				Computed:            true,
@@ -182,6 +198,12 @@ func (r *IndexerTorrentRssResource) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
+func (r *IndexerTorrentRssResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *IndexerTorrentRssResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -198,12 +220,18 @@ func (r *IndexerTorrentRssResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerTorrentRss
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerTorrentRssResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, indexerTorrentRssResourceName, err)...)
 
 		return
 	}
@@ -211,6 +239,7 @@ func (r *IndexerTorrentRssResource) Create(ctx context.Context, req resource.Cre
 	tflog.Trace(ctx, "created "+indexerTorrentRssResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -225,9 +254,11 @@ func (r *IndexerTorrentRssResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get IndexerTorrentRss current value
-	response, _, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerTorrentRssResourceName, err))
+		if !helpers.RemoveNotFound(ctx, indexerTorrentRssResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, indexerTorrentRssResourceName, err)...)
+		}
 
 		return
 	}
@@ -235,6 +266,7 @@ func (r *IndexerTorrentRssResource) Read(ctx context.Context, req resource.ReadR
 	tflog.Trace(ctx, "read "+indexerTorrentRssResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -248,12 +280,18 @@ func (r *IndexerTorrentRssResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerTorrentRss
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, fmt.Sprintf("Unable to update "+indexerTorrentRssResourceName+", got error: %s", err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, indexerTorrentRssResourceName, err)...)
 
 		return
 	}
@@ -261,6 +299,7 @@ func (r *IndexerTorrentRssResource) Update(ctx context.Context, req resource.Upd
 	tflog.Trace(ctx, "updated "+indexerTorrentRssResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -276,7 +315,7 @@ func (r *IndexerTorrentRssResource) Delete(ctx context.Context, req resource.Del
 	// Delete IndexerTorrentRss current value
 	_, err := r.client.IndexerApi.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerTorrentRssResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, indexerTorrentRssResourceName, err)...)
 
 		return
 	}
@@ -290,6 +329,12 @@ func (r *IndexerTorrentRssResource) ImportState(ctx context.Context, req resourc
 	tflog.Trace(ctx, "imported "+indexerTorrentRssResourceName+": "+req.ID)
 }
 
+func (r *IndexerTorrentRssResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewIndexerResource(), "implementation", indexerTorrentRssImplementation),
+	}
+}
+
 func (i *IndexerTorrentRss) write(ctx context.Context, indexer *whisparr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
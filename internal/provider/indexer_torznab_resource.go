@@ -2,17 +2,18 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -26,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &IndexerTorznabResource{}
-	_ resource.ResourceWithImportState = &IndexerTorznabResource{}
+	_ resource.Resource                 = &IndexerTorznabResource{}
+	_ resource.ResourceWithImportState  = &IndexerTorznabResource{}
+	_ resource.ResourceWithMoveState    = &IndexerTorznabResource{}
+	_ resource.ResourceWithUpgradeState = &IndexerTorznabResource{}
 )
 
 func NewIndexerTorznabResource() resource.Resource {
@@ -36,13 +39,14 @@ func NewIndexerTorznabResource() resource.Resource {
 
 // IndexerTorznabResource defines the Torznab indexer implementation.
 type IndexerTorznabResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // IndexerTorznab describes the Torznab indexer data model.
 type IndexerTorznab struct {
 	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
 	Tags                    types.Set     `tfsdk:"tags"`
+	TagLabels               types.Set     `tfsdk:"tag_labels"`
 	Categories              types.Set     `tfsdk:"categories"`
 	MultiLanguages          types.Set     `tfsdk:"multi_languages"`
 	RequiredFlags           types.Set     `tfsdk:"required_flags"`
@@ -78,6 +82,7 @@ func (i IndexerTorznab) toIndexer() *Indexer {
 		APIPath:                 i.APIPath,
 		APIKey:                  i.APIKey,
 		Tags:                    i.Tags,
+		TagLabels:               i.TagLabels,
 		Categories:              i.Categories,
 		MultiLanguages:          i.MultiLanguages,
 		RequiredFlags:           i.RequiredFlags,
@@ -103,6 +108,7 @@ func (i *IndexerTorznab) fromIndexer(indexer *Indexer) {
 	i.SeedRatio = indexer.SeedRatio
 	i.BaseURL = indexer.BaseURL
 	i.Tags = indexer.Tags
+	i.TagLabels = indexer.TagLabels
 	i.Categories = indexer.Categories
 	i.MultiLanguages = indexer.MultiLanguages
 	i.RequiredFlags = indexer.RequiredFlags
@@ -115,6 +121,7 @@ func (r *IndexerTorznabResource) Metadata(_ context.Context, req resource.Metada
 func (r *IndexerTorznabResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Indexer Torznab resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/whisparr/settings#indexers) and [Torznab](https://wiki.servarr.com/whisparr/supported#torznab).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
@@ -151,6 +158,15 @@ func (r *IndexerTorznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerTorznab ID.",
 				Computed:            true,
@@ -216,6 +232,12 @@ func (r *IndexerTorznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 	}
 }
 
+func (r *IndexerTorznabResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *IndexerTorznabResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -232,12 +254,18 @@ func (r *IndexerTorznabResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerTorznab
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerTorznabResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, indexerTorznabResourceName, err)...)
 
 		return
 	}
@@ -245,6 +273,7 @@ func (r *IndexerTorznabResource) Create(ctx context.Context, req resource.Create
 	tflog.Trace(ctx, "created "+indexerTorznabResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -259,9 +288,11 @@ func (r *IndexerTorznabResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get IndexerTorznab current value
-	response, _, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerApi.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerTorznabResourceName, err))
+		if !helpers.RemoveNotFound(ctx, indexerTorznabResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, indexerTorznabResourceName, err)...)
+		}
 
 		return
 	}
@@ -269,6 +300,7 @@ func (r *IndexerTorznabResource) Read(ctx context.Context, req resource.ReadRequ
 	tflog.Trace(ctx, "read "+indexerTorznabResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -282,12 +314,18 @@ func (r *IndexerTorznabResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerTorznab
 	request := indexer.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.IndexerApi.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, fmt.Sprintf("Unable to update "+indexerTorznabResourceName+", got error: %s", err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, indexerTorznabResourceName, err)...)
 
 		return
 	}
@@ -295,6 +333,7 @@ func (r *IndexerTorznabResource) Update(ctx context.Context, req resource.Update
 	tflog.Trace(ctx, "updated "+indexerTorznabResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
+	indexer.TagLabels = r.client.TagLabels(ctx, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -310,7 +349,7 @@ func (r *IndexerTorznabResource) Delete(ctx context.Context, req resource.Delete
 	// Delete IndexerTorznab current value
 	_, err := r.client.IndexerApi.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerTorznabResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, indexerTorznabResourceName, err)...)
 
 		return
 	}
@@ -324,6 +363,12 @@ func (r *IndexerTorznabResource) ImportState(ctx context.Context, req resource.I
 	tflog.Trace(ctx, "imported "+indexerTorznabResourceName+": "+req.ID)
 }
 
+func (r *IndexerTorznabResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewIndexerResource(), "implementation", indexerTorznabImplementation),
+	}
+}
+
 func (i *IndexerTorznab) write(ctx context.Context, indexer *whisparr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
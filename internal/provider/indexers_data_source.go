@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,7 +22,7 @@ func NewIndexersDataSource() datasource.DataSource {
 
 // IndexersDataSource defines the indexers implementation.
 type IndexersDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // Indexers describes the indexers data model.
@@ -91,6 +90,11 @@ func (d *IndexersDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"tag_labels": schema.SetAttribute{
+							MarkdownDescription: "List of associated tag labels.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 						"id": schema.Int64Attribute{
 							MarkdownDescription: "Indexer ID.",
 							Computed:            true,
@@ -202,16 +206,19 @@ func (d *IndexersDataSource) Read(ctx context.Context, _ datasource.ReadRequest,
 	// Get indexers current value
 	response, _, err := d.client.IndexerApi.ListIndexer(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, indexersDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.List, indexersDataSourceName, err)...)
 
 		return
 	}
 
 	tflog.Trace(ctx, "read "+indexersDataSourceName)
+	labels := d.client.TagLabelMap(ctx, &resp.Diagnostics)
+
 	// Map response body to resource schema attribute
 	indexers := make([]Indexer, len(response))
 	for i, p := range response {
 		indexers[i].write(ctx, p, &resp.Diagnostics)
+		indexers[i].TagLabels = helpers.MapTagLabels(ctx, indexers[i].Tags, labels, &resp.Diagnostics)
 	}
 
 	indexerList, diags := types.SetValueFrom(ctx, Indexer{}.getType(), indexers)
@@ -24,7 +24,7 @@ func NewLanguageDataSource() datasource.DataSource {
 
 // LanguageDataSource defines the language implementation.
 type LanguageDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // Language defines the language data model.
@@ -85,7 +85,7 @@ func (d *LanguageDataSource) Read(ctx context.Context, req datasource.ReadReques
 	// Get languages current value
 	response, _, err := d.client.LanguageApi.ListLanguage(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, languageDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, languageDataSourceName, err)...)
 
 		return
 	}
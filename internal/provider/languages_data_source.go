@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,7 +22,7 @@ func NewLanguagesDataSource() datasource.DataSource {
 
 // LanguagesDataSource defines the languages implementation.
 type LanguagesDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // Languages describes the languages data model.
@@ -78,7 +77,7 @@ func (d *LanguagesDataSource) Read(ctx context.Context, _ datasource.ReadRequest
 	// Get languages current value
 	response, _, err := d.client.LanguageApi.ListLanguage(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, languagesDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, languagesDataSourceName, err)...)
 
 		return
 	}
@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
+	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const manualImportCandidatesDataSourceName = "manual_import_candidates"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ManualImportCandidatesDataSource{}
+
+func NewManualImportCandidatesDataSource() datasource.DataSource {
+	return &ManualImportCandidatesDataSource{}
+}
+
+// ManualImportCandidatesDataSource defines the manual import candidates implementation.
+type ManualImportCandidatesDataSource struct {
+	client *helpers.Client
+}
+
+// ManualImportCandidates describes the manual import candidates data model.
+type ManualImportCandidates struct {
+	Candidates          types.Set    `tfsdk:"candidates"`
+	Folder              types.String `tfsdk:"folder"`
+	DownloadID          types.String `tfsdk:"download_id"`
+	ID                  types.String `tfsdk:"id"`
+	MovieID             types.Int64  `tfsdk:"movie_id"`
+	FilterExistingFiles types.Bool   `tfsdk:"filter_existing_files"`
+}
+
+// ManualImportCandidate is part of ManualImportCandidates.
+type ManualImportCandidate struct {
+	Languages       types.Set    `tfsdk:"languages"`
+	Rejections      types.Set    `tfsdk:"rejections"`
+	Path            types.String `tfsdk:"path"`
+	RelativePath    types.String `tfsdk:"relative_path"`
+	FolderName      types.String `tfsdk:"folder_name"`
+	Name            types.String `tfsdk:"name"`
+	ReleaseGroup    types.String `tfsdk:"release_group"`
+	DownloadID      types.String `tfsdk:"download_id"`
+	QualityName     types.String `tfsdk:"quality_name"`
+	ID              types.Int64  `tfsdk:"id"`
+	Size            types.Int64  `tfsdk:"size"`
+	MovieID         types.Int64  `tfsdk:"movie_id"`
+	QualityID       types.Int64  `tfsdk:"quality_id"`
+	QualityRevision types.Int64  `tfsdk:"quality_revision"`
+}
+
+func (c ManualImportCandidate) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"languages":        types.SetType{}.WithElementType(types.Int64Type),
+			"rejections":       types.SetType{}.WithElementType(ManualImportRejection{}.getType()),
+			"path":             types.StringType,
+			"relative_path":    types.StringType,
+			"folder_name":      types.StringType,
+			"name":             types.StringType,
+			"release_group":    types.StringType,
+			"download_id":      types.StringType,
+			"quality_name":     types.StringType,
+			"id":               types.Int64Type,
+			"size":             types.Int64Type,
+			"movie_id":         types.Int64Type,
+			"quality_id":       types.Int64Type,
+			"quality_revision": types.Int64Type,
+		})
+}
+
+// ManualImportRejection is part of ManualImportCandidate.
+type ManualImportRejection struct {
+	Reason types.String `tfsdk:"reason"`
+	Type   types.String `tfsdk:"type"`
+}
+
+func (r ManualImportRejection) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"reason": types.StringType,
+			"type":   types.StringType,
+		})
+}
+
+func (d *ManualImportCandidatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + manualImportCandidatesDataSourceName
+}
+
+func (d *ManualImportCandidatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Media Management -->List all candidates for a [Manual Import](../resources/manual_import) of a folder or download.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"folder": schema.StringAttribute{
+				MarkdownDescription: "Folder to scan. Exactly one of `folder` and `download_id` must be defined.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("download_id")),
+				},
+			},
+			"download_id": schema.StringAttribute{
+				MarkdownDescription: "Download ID to scan. Exactly one of `folder` and `download_id` must be defined.",
+				Optional:            true,
+			},
+			"movie_id": schema.Int64Attribute{
+				MarkdownDescription: "Movie ID to match candidates against.",
+				Optional:            true,
+			},
+			"filter_existing_files": schema.BoolAttribute{
+				MarkdownDescription: "Exclude files already imported.",
+				Optional:            true,
+			},
+			"candidates": schema.SetNestedAttribute{
+				MarkdownDescription: "Candidate list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Candidate ID.",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "File path.",
+							Computed:            true,
+						},
+						"relative_path": schema.StringAttribute{
+							MarkdownDescription: "File relative path.",
+							Computed:            true,
+						},
+						"folder_name": schema.StringAttribute{
+							MarkdownDescription: "Folder name.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "File name.",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "File size.",
+							Computed:            true,
+						},
+						"movie_id": schema.Int64Attribute{
+							MarkdownDescription: "Matched movie ID.",
+							Computed:            true,
+						},
+						"quality_id": schema.Int64Attribute{
+							MarkdownDescription: "Detected quality ID.",
+							Computed:            true,
+						},
+						"quality_name": schema.StringAttribute{
+							MarkdownDescription: "Detected quality name.",
+							Computed:            true,
+						},
+						"quality_revision": schema.Int64Attribute{
+							MarkdownDescription: "Detected quality revision.",
+							Computed:            true,
+						},
+						"languages": schema.SetAttribute{
+							MarkdownDescription: "Detected language IDs.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"release_group": schema.StringAttribute{
+							MarkdownDescription: "Release group.",
+							Computed:            true,
+						},
+						"download_id": schema.StringAttribute{
+							MarkdownDescription: "Download ID.",
+							Computed:            true,
+						},
+						"rejections": schema.SetNestedAttribute{
+							MarkdownDescription: "Rejection reasons.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"reason": schema.StringAttribute{
+										MarkdownDescription: "Rejection reason.",
+										Computed:            true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: "Rejection type.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ManualImportCandidatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *ManualImportCandidatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *ManualImportCandidates
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get manual import candidates current value
+	request := d.client.ManualImportApi.ListManualImport(ctx)
+	if !data.Folder.IsNull() {
+		request = request.Folder(data.Folder.ValueString())
+	}
+
+	if !data.DownloadID.IsNull() {
+		request = request.DownloadId(data.DownloadID.ValueString())
+	}
+
+	if !data.MovieID.IsNull() {
+		request = request.MovieId(int32(data.MovieID.ValueInt64()))
+	}
+
+	if !data.FilterExistingFiles.IsNull() {
+		request = request.FilterExistingFiles(data.FilterExistingFiles.ValueBool())
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.List, manualImportCandidatesDataSourceName, err)...)
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+manualImportCandidatesDataSourceName)
+	// Map response body to resource schema attribute
+	candidates := make([]ManualImportCandidate, len(response))
+	for i, c := range response {
+		candidates[i].write(ctx, c, &resp.Diagnostics)
+	}
+
+	candidateList, diags := types.SetValueFrom(ctx, ManualImportCandidate{}.getType(), candidates)
+	resp.Diagnostics.Append(diags...)
+
+	data.Candidates = candidateList
+	data.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (c *ManualImportCandidate) write(ctx context.Context, candidate *whisparr.ManualImportResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	c.ID = types.Int64Value(int64(candidate.GetId()))
+	c.Path = types.StringValue(candidate.GetPath())
+	c.RelativePath = types.StringValue(candidate.GetRelativePath())
+	c.FolderName = types.StringValue(candidate.GetFolderName())
+	c.Name = types.StringValue(candidate.GetName())
+	c.Size = types.Int64Value(candidate.GetSize())
+	c.MovieID = types.Int64Value(int64(candidate.Movie.GetId()))
+	quality := candidate.GetQuality()
+	c.QualityID = types.Int64Value(int64(quality.Quality.GetId()))
+	c.QualityName = types.StringValue(quality.Quality.GetName())
+	c.QualityRevision = types.Int64Value(int64(quality.Revision.GetVersion()))
+	c.ReleaseGroup = types.StringValue(candidate.GetReleaseGroup())
+	c.DownloadID = types.StringValue(candidate.GetDownloadId())
+
+	languages := make([]int64, len(candidate.GetLanguages()))
+	for i, l := range candidate.GetLanguages() {
+		languages[i] = int64(l.GetId())
+	}
+
+	c.Languages, tempDiag = types.SetValueFrom(ctx, types.Int64Type, languages)
+	diags.Append(tempDiag...)
+
+	rejections := make([]ManualImportRejection, len(candidate.GetRejections()))
+	for i, r := range candidate.GetRejections() {
+		rejections[i].write(r)
+	}
+
+	c.Rejections, tempDiag = types.SetValueFrom(ctx, ManualImportRejection{}.getType(), rejections)
+	diags.Append(tempDiag...)
+}
+
+func (r *ManualImportRejection) write(rejection *whisparr.Rejection) {
+	r.Reason = types.StringValue(rejection.GetReason())
+	r.Type = types.StringValue(string(rejection.GetType()))
+}
@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
+	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	manualImportResourceName = "manual_import"
+	manualImportCommandName  = "ManualImport"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ManualImportResource{}
+
+func NewManualImportResource() resource.Resource {
+	return &ManualImportResource{}
+}
+
+// ManualImportResource defines the manual import implementation.
+type ManualImportResource struct {
+	client *helpers.Client
+}
+
+// ManualImport describes the manual import data model.
+type ManualImport struct {
+	Files      types.Set    `tfsdk:"files"`
+	ImportMode types.String `tfsdk:"import_mode"`
+	Status     types.String `tfsdk:"status"`
+	ID         types.Int64  `tfsdk:"id"`
+}
+
+// ManualImportFile is part of ManualImport.
+type ManualImportFile struct {
+	Languages       types.Set    `tfsdk:"languages"`
+	Path            types.String `tfsdk:"path"`
+	ReleaseGroup    types.String `tfsdk:"release_group"`
+	DownloadID      types.String `tfsdk:"download_id"`
+	MovieID         types.Int64  `tfsdk:"movie_id"`
+	QualityID       types.Int64  `tfsdk:"quality_id"`
+	QualityRevision types.Int64  `tfsdk:"quality_revision"`
+}
+
+func (f ManualImportFile) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"languages":        types.SetType{}.WithElementType(types.Int64Type),
+			"path":             types.StringType,
+			"release_group":    types.StringType,
+			"download_id":      types.StringType,
+			"movie_id":         types.Int64Type,
+			"quality_id":       types.Int64Type,
+			"quality_revision": types.Int64Type,
+		})
+}
+
+// manualImportCommand is the ManualImport command payload, not modeled by the SDK.
+type manualImportCommand struct {
+	Name       string                    `json:"name"`
+	ImportMode string                    `json:"importMode"`
+	Files      []manualImportCommandFile `json:"files"`
+}
+
+type manualImportCommandFile struct {
+	Quality      *whisparr.QualityModel `json:"quality"`
+	Path         string                 `json:"path"`
+	ReleaseGroup string                 `json:"releaseGroup,omitempty"`
+	DownloadID   string                 `json:"downloadId,omitempty"`
+	Languages    []*whisparr.Language   `json:"languages"`
+	MovieID      int32                  `json:"movieId"`
+}
+
+func (r *ManualImportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + manualImportResourceName
+}
+
+func (r *ManualImportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Media Management -->Manual Import resource.\nImports the chosen [candidates](../data-sources/manual_import_candidates) and waits for the import to complete.\n" +
+			"Any change triggers a new import, destroying the resource only removes it from the state.\nFor more information refer to [Manual Import](https://wiki.servarr.com/whisparr/activity#manual-import) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"import_mode": schema.StringAttribute{
+				MarkdownDescription: "Import mode. Allowed values: 'auto', 'move', 'copy'.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "move", "copy"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"files": schema.SetNestedAttribute{
+				MarkdownDescription: "Files to import.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: r.getFileSchema().Attributes,
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Manual Import command ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Manual Import command status.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r ManualImportResource) getFileSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				MarkdownDescription: "File path.",
+				Required:            true,
+			},
+			"movie_id": schema.Int64Attribute{
+				MarkdownDescription: "Movie ID.",
+				Required:            true,
+			},
+			"quality_id": schema.Int64Attribute{
+				MarkdownDescription: "Quality ID.",
+				Required:            true,
+			},
+			"quality_revision": schema.Int64Attribute{
+				MarkdownDescription: "Quality revision. Defaults to `1`.",
+				Optional:            true,
+			},
+			"languages": schema.SetAttribute{
+				MarkdownDescription: "Language IDs.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"release_group": schema.StringAttribute{
+				MarkdownDescription: "Release group.",
+				Optional:            true,
+			},
+			"download_id": schema.StringAttribute{
+				MarkdownDescription: "Download ID.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *ManualImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *ManualImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var manualImport *ManualImport
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &manualImport)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Qualities are needed to build the full quality model
+	qualities, _, err := r.client.QualityDefinitionApi.ListQualityDefinition(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, qualityDataSourceName, err)...)
+
+		return
+	}
+
+	// Send ManualImport command
+	request := manualImport.read(ctx, qualities, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.client.SendCommand(ctx, request)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Create, manualImportResourceName, err)...)
+
+		return
+	}
+
+	response, err = r.client.WaitCommand(ctx, response.GetId(), helpers.CommandTimeout)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Create, manualImportResourceName, err)...)
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+manualImportResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	manualImport.ID = types.Int64Value(int64(response.GetId()))
+	manualImport.Status = types.StringValue(string(response.GetStatus()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &manualImport)...)
+}
+
+// Commands are purged by Whisparr, state is kept as is.
+func (r *ManualImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var manualImport *ManualImport
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &manualImport)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+manualImportResourceName+": "+strconv.Itoa(int(manualImport.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &manualImport)...)
+}
+
+// never used.
+func (r *ManualImportResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r *ManualImportResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Imported files are not removed
+	tflog.Trace(ctx, "decoupled "+manualImportResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+func (m *ManualImport) read(ctx context.Context, qualities []*whisparr.QualityDefinitionResource, diags *diag.Diagnostics) *manualImportCommand {
+	files := make([]ManualImportFile, len(m.Files.Elements()))
+	diags.Append(m.Files.ElementsAs(ctx, &files, false)...)
+
+	command := &manualImportCommand{
+		Name:       manualImportCommandName,
+		ImportMode: m.ImportMode.ValueString(),
+		Files:      make([]manualImportCommandFile, len(files)),
+	}
+
+	for i, f := range files {
+		command.Files[i] = f.read(ctx, qualities, diags)
+	}
+
+	return command
+}
+
+func (f *ManualImportFile) read(ctx context.Context, qualities []*whisparr.QualityDefinitionResource, diags *diag.Diagnostics) manualImportCommandFile {
+	file := manualImportCommandFile{
+		Path:         f.Path.ValueString(),
+		MovieID:      int32(f.MovieID.ValueInt64()),
+		ReleaseGroup: f.ReleaseGroup.ValueString(),
+		DownloadID:   f.DownloadID.ValueString(),
+		Languages:    []*whisparr.Language{},
+	}
+
+	var languages []int64
+
+	diags.Append(f.Languages.ElementsAs(ctx, &languages, true)...)
+
+	for _, l := range languages {
+		language := whisparr.NewLanguage()
+		language.SetId(int32(l))
+		file.Languages = append(file.Languages, language)
+	}
+
+	revision := whisparr.NewRevision()
+	revision.SetVersion(1)
+
+	if !f.QualityRevision.IsNull() {
+		revision.SetVersion(int32(f.QualityRevision.ValueInt64()))
+	}
+
+	file.Quality = whisparr.NewQualityModel()
+	file.Quality.SetRevision(*revision)
+
+	for _, q := range qualities {
+		if int64(q.Quality.GetId()) == f.QualityID.ValueInt64() {
+			file.Quality.SetQuality(q.GetQuality())
+
+			return file
+		}
+	}
+
+	diags.AddError(helpers.ResourceError, helpers.ParseNotFoundError(qualityDataSourceName, "id", strconv.Itoa(int(f.QualityID.ValueInt64()))))
+
+	return file
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccManualImportResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccManualImportResourceConfig(1) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Unknown quality
+			{
+				Config:      testAccManualImportResourceConfig(9999),
+				ExpectError: regexp.MustCompile("Resource Error"),
+			},
+		},
+	})
+}
+
+func testAccManualImportResourceConfig(quality int) string {
+	return fmt.Sprintf(`
+		resource "whisparr_manual_import" "test" {
+			import_mode = "copy"
+			files = [
+				{
+					path = "/downloads/movie.mkv"
+					movie_id = 1
+					quality_id = %d
+					languages = [1]
+				}
+			]
+		}
+	`, quality)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,7 +20,7 @@ func NewMediaManagementDataSource() datasource.DataSource {
 
 // MediaManagementDataSource defines the media management implementation.
 type MediaManagementDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *MediaManagementDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -127,7 +126,7 @@ func (d *MediaManagementDataSource) Read(ctx context.Context, _ datasource.ReadR
 	// Get indexer config current value
 	response, _, err := d.client.MediaManagementConfigApi.GetMediaManagementConfig(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, mediaManagementDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, mediaManagementDataSourceName, err)...)
 
 		return
 	}
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,7 +20,7 @@ func NewMetadataConfigDataSource() datasource.DataSource {
 
 // MetadataConfigDataSource defines the metadata config implementation.
 type MetadataConfigDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *MetadataConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -55,7 +54,7 @@ func (d *MetadataConfigDataSource) Read(ctx context.Context, _ datasource.ReadRe
 	// Get metadata config current value
 	response, _, err := d.client.MetadataConfigApi.GetMetadataConfig(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataConfigDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, metadataConfigDataSourceName, err)...)
 
 		return
 	}
@@ -31,7 +31,7 @@ func NewMetadataConfigResource() resource.Resource {
 
 // MetadataConfigResource defines the metadata config implementation.
 type MetadataConfigResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // MetadataConfig describes the metadata config data model.
@@ -89,7 +89,7 @@ func (r *MetadataConfigResource) Create(ctx context.Context, req resource.Create
 	// Create new MetadataConfig
 	response, _, err := r.client.MetadataConfigApi.UpdateMetadataConfig(ctx, strconv.Itoa(int(request.GetId()))).MetadataConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataConfigResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Create, metadataConfigResourceName, err)...)
 
 		return
 	}
@@ -113,7 +113,7 @@ func (r *MetadataConfigResource) Read(ctx context.Context, req resource.ReadRequ
 	// Get metadataConfig current value
 	response, _, err := r.client.MetadataConfigApi.GetMetadataConfig(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataConfigResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, metadataConfigResourceName, err)...)
 
 		return
 	}
@@ -140,7 +140,7 @@ func (r *MetadataConfigResource) Update(ctx context.Context, req resource.Update
 	// Update MetadataConfig
 	response, _, err := r.client.MetadataConfigApi.UpdateMetadataConfig(ctx, strconv.Itoa(int(request.GetId()))).MetadataConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataConfigResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Update, metadataConfigResourceName, err)...)
 
 		return
 	}
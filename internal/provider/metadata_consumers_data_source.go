@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,7 +22,7 @@ func NewMetadataConsumersDataSource() datasource.DataSource {
 
 // MetadataConsumersDataSource defines the metadataConsumers implementation.
 type MetadataConsumersDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // MetadataConsumers describes the metadataConsumers data model.
@@ -71,6 +70,11 @@ func (d *MetadataConsumersDataSource) Schema(_ context.Context, _ datasource.Sch
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"tag_labels": schema.SetAttribute{
+							MarkdownDescription: "List of associated tag labels.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 						"id": schema.Int64Attribute{
 							MarkdownDescription: "Metadata ID.",
 							Computed:            true,
@@ -113,16 +117,19 @@ func (d *MetadataConsumersDataSource) Read(ctx context.Context, _ datasource.Rea
 	// Get metadataConsumers current value
 	response, _, err := d.client.MetadataApi.ListMetadata(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, metadataConsumersDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.List, metadataConsumersDataSourceName, err)...)
 
 		return
 	}
 
 	tflog.Trace(ctx, "read "+metadataConsumersDataSourceName)
+	labels := d.client.TagLabelMap(ctx, &resp.Diagnostics)
+
 	// Map response body to resource schema attribute
 	consumers := make([]Metadata, len(response))
 	for i, p := range response {
 		consumers[i].write(ctx, p, &resp.Diagnostics)
+		consumers[i].TagLabels = helpers.MapTagLabels(ctx, consumers[i].Tags, labels, &resp.Diagnostics)
 	}
 
 	metadataList, diags := types.SetValueFrom(ctx, Metadata{}.getType(), consumers)
@@ -23,7 +23,7 @@ func NewMetadataDataSource() datasource.DataSource {
 
 // MetadataDataSource defines the metadata implementation.
 type MetadataDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *MetadataDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -56,6 +56,11 @@ func (d *MetadataDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Metadata ID.",
 				Computed:            true,
@@ -102,12 +107,13 @@ func (d *MetadataDataSource) Read(ctx context.Context, req datasource.ReadReques
 	// Get metadata current value
 	response, _, err := d.client.MetadataApi.ListMetadata(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, metadataDataSourceName, err)...)
 
 		return
 	}
 
 	data.find(ctx, data.Name.ValueString(), response, &resp.Diagnostics)
+	data.TagLabels = d.client.TagLabels(ctx, data.Tags, &resp.Diagnostics)
 	tflog.Trace(ctx, "read "+metadataDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -2,17 +2,18 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -25,8 +26,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &MetadataEmbyResource{}
-	_ resource.ResourceWithImportState = &MetadataEmbyResource{}
+	_ resource.Resource                 = &MetadataEmbyResource{}
+	_ resource.ResourceWithImportState  = &MetadataEmbyResource{}
+	_ resource.ResourceWithMoveState    = &MetadataEmbyResource{}
+	_ resource.ResourceWithUpgradeState = &MetadataEmbyResource{}
 )
 
 func NewMetadataEmbyResource() resource.Resource {
@@ -35,12 +38,13 @@ func NewMetadataEmbyResource() resource.Resource {
 
 // MetadataEmbyResource defines the Emby metadata implementation.
 type MetadataEmbyResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // MetadataEmby describes the Emby metadata data model.
 type MetadataEmby struct {
 	Tags          types.Set    `tfsdk:"tags"`
+	TagLabels     types.Set    `tfsdk:"tag_labels"`
 	Name          types.String `tfsdk:"name"`
 	ID            types.Int64  `tfsdk:"id"`
 	Enable        types.Bool   `tfsdk:"enable"`
@@ -50,6 +54,7 @@ type MetadataEmby struct {
 func (m MetadataEmby) toMetadata() *Metadata {
 	return &Metadata{
 		Tags:           m.Tags,
+		TagLabels:      m.TagLabels,
 		Name:           m.Name,
 		ID:             m.ID,
 		Enable:         m.Enable,
@@ -63,6 +68,7 @@ func (m *MetadataEmby) fromMetadata(metadata *Metadata) {
 	m.ID = metadata.ID
 	m.Name = metadata.Name
 	m.Tags = metadata.Tags
+	m.TagLabels = metadata.TagLabels
 	m.Enable = metadata.Enable
 	m.MovieMetadata = metadata.MovieMetadata
 }
@@ -74,6 +80,7 @@ func (r *MetadataEmbyResource) Metadata(_ context.Context, req resource.Metadata
 func (r *MetadataEmbyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Metadata -->Metadata Emby resource.\nFor more information refer to [Metadata](https://wiki.servarr.com/whisparr/settings#metadata) and [Emby](https://wiki.servarr.com/whisparr/supported#mediabrowsermetadata).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -90,6 +97,15 @@ func (r *MetadataEmbyResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Metadata ID.",
 				Computed:            true,
@@ -106,6 +122,12 @@ func (r *MetadataEmbyResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func (r *MetadataEmbyResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *MetadataEmbyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -122,12 +144,18 @@ func (r *MetadataEmbyResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, metadata.TagLabels, &metadata.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new MetadataEmby
 	request := metadata.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.MetadataApi.CreateMetadata(ctx).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataEmbyResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, metadataEmbyResourceName, err)...)
 
 		return
 	}
@@ -135,6 +163,7 @@ func (r *MetadataEmbyResource) Create(ctx context.Context, req resource.CreateRe
 	tflog.Trace(ctx, "created "+metadataEmbyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	metadata.write(ctx, response, &resp.Diagnostics)
+	metadata.TagLabels = r.client.TagLabels(ctx, metadata.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
 
@@ -149,9 +178,11 @@ func (r *MetadataEmbyResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get MetadataEmby current value
-	response, _, err := r.client.MetadataApi.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataApi.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataEmbyResourceName, err))
+		if !helpers.RemoveNotFound(ctx, metadataEmbyResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, metadataEmbyResourceName, err)...)
+		}
 
 		return
 	}
@@ -159,6 +190,7 @@ func (r *MetadataEmbyResource) Read(ctx context.Context, req resource.ReadReques
 	tflog.Trace(ctx, "read "+metadataEmbyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	metadata.write(ctx, response, &resp.Diagnostics)
+	metadata.TagLabels = r.client.TagLabels(ctx, metadata.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
 
@@ -172,12 +204,18 @@ func (r *MetadataEmbyResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, metadata.TagLabels, &metadata.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update MetadataEmby
 	request := metadata.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.MetadataApi.UpdateMetadata(ctx, strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, fmt.Sprintf("Unable to update "+metadataEmbyResourceName+", got error: %s", err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, metadataEmbyResourceName, err)...)
 
 		return
 	}
@@ -185,6 +223,7 @@ func (r *MetadataEmbyResource) Update(ctx context.Context, req resource.UpdateRe
 	tflog.Trace(ctx, "updated "+metadataEmbyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	metadata.write(ctx, response, &resp.Diagnostics)
+	metadata.TagLabels = r.client.TagLabels(ctx, metadata.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
 
@@ -200,7 +239,7 @@ func (r *MetadataEmbyResource) Delete(ctx context.Context, req resource.DeleteRe
 	// Delete MetadataEmby current value
 	_, err := r.client.MetadataApi.DeleteMetadata(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataEmbyResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, metadataEmbyResourceName, err)...)
 
 		return
 	}
@@ -214,6 +253,12 @@ func (r *MetadataEmbyResource) ImportState(ctx context.Context, req resource.Imp
 	tflog.Trace(ctx, "imported "+metadataEmbyResourceName+": "+req.ID)
 }
 
+func (r *MetadataEmbyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewMetadataResource(), "implementation", metadataEmbyImplementation),
+	}
+}
+
 func (m *MetadataEmby) write(ctx context.Context, metadata *whisparr.MetadataResource, diags *diag.Diagnostics) {
 	genericMetadata := m.toMetadata()
 	genericMetadata.write(ctx, metadata, diags)
@@ -2,17 +2,18 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -25,8 +26,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &MetadataKodiResource{}
-	_ resource.ResourceWithImportState = &MetadataKodiResource{}
+	_ resource.Resource                 = &MetadataKodiResource{}
+	_ resource.ResourceWithImportState  = &MetadataKodiResource{}
+	_ resource.ResourceWithMoveState    = &MetadataKodiResource{}
+	_ resource.ResourceWithUpgradeState = &MetadataKodiResource{}
 )
 
 func NewMetadataKodiResource() resource.Resource {
@@ -35,12 +38,13 @@ func NewMetadataKodiResource() resource.Resource {
 
 // MetadataKodiResource defines the Kodi metadata implementation.
 type MetadataKodiResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // MetadataKodi describes the Kodi metadata data model.
 type MetadataKodi struct {
 	Tags                  types.Set    `tfsdk:"tags"`
+	TagLabels             types.Set    `tfsdk:"tag_labels"`
 	Name                  types.String `tfsdk:"name"`
 	ID                    types.Int64  `tfsdk:"id"`
 	MovieMetadataLanguage types.Int64  `tfsdk:"movie_metadata_language"`
@@ -54,6 +58,7 @@ type MetadataKodi struct {
 func (m MetadataKodi) toMetadata() *Metadata {
 	return &Metadata{
 		Tags:                  m.Tags,
+		TagLabels:             m.TagLabels,
 		Name:                  m.Name,
 		ID:                    m.ID,
 		MovieMetadataLanguage: m.MovieMetadataLanguage,
@@ -71,6 +76,7 @@ func (m *MetadataKodi) fromMetadata(metadata *Metadata) {
 	m.ID = metadata.ID
 	m.Name = metadata.Name
 	m.Tags = metadata.Tags
+	m.TagLabels = metadata.TagLabels
 	m.MovieMetadataLanguage = metadata.MovieMetadataLanguage
 	m.Enable = metadata.Enable
 	m.MovieMetadata = metadata.MovieMetadata
@@ -86,6 +92,7 @@ func (r *MetadataKodiResource) Metadata(_ context.Context, req resource.Metadata
 func (r *MetadataKodiResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Metadata -->Metadata Kodi resource.\nFor more information refer to [Metadata](https://wiki.servarr.com/whisparr/settings#metadata) and [KODI](https://wiki.servarr.com/whisparr/supported#xbmcmetadata).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -102,6 +109,15 @@ func (r *MetadataKodiResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Metadata ID.",
 				Computed:            true,
@@ -134,6 +150,12 @@ func (r *MetadataKodiResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func (r *MetadataKodiResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *MetadataKodiResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -150,12 +172,18 @@ func (r *MetadataKodiResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, metadata.TagLabels, &metadata.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new MetadataKodi
 	request := metadata.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.MetadataApi.CreateMetadata(ctx).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataKodiResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, metadataKodiResourceName, err)...)
 
 		return
 	}
@@ -163,6 +191,7 @@ func (r *MetadataKodiResource) Create(ctx context.Context, req resource.CreateRe
 	tflog.Trace(ctx, "created "+metadataKodiResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	metadata.write(ctx, response, &resp.Diagnostics)
+	metadata.TagLabels = r.client.TagLabels(ctx, metadata.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
 
@@ -177,9 +206,11 @@ func (r *MetadataKodiResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get MetadataKodi current value
-	response, _, err := r.client.MetadataApi.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataApi.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataKodiResourceName, err))
+		if !helpers.RemoveNotFound(ctx, metadataKodiResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, metadataKodiResourceName, err)...)
+		}
 
 		return
 	}
@@ -187,6 +218,7 @@ func (r *MetadataKodiResource) Read(ctx context.Context, req resource.ReadReques
 	tflog.Trace(ctx, "read "+metadataKodiResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	metadata.write(ctx, response, &resp.Diagnostics)
+	metadata.TagLabels = r.client.TagLabels(ctx, metadata.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
 
@@ -200,12 +232,18 @@ func (r *MetadataKodiResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, metadata.TagLabels, &metadata.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update MetadataKodi
 	request := metadata.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.MetadataApi.UpdateMetadata(ctx, strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, fmt.Sprintf("Unable to update "+metadataKodiResourceName+", got error: %s", err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, metadataKodiResourceName, err)...)
 
 		return
 	}
@@ -213,6 +251,7 @@ func (r *MetadataKodiResource) Update(ctx context.Context, req resource.UpdateRe
 	tflog.Trace(ctx, "updated "+metadataKodiResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	metadata.write(ctx, response, &resp.Diagnostics)
+	metadata.TagLabels = r.client.TagLabels(ctx, metadata.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
 
@@ -228,7 +267,7 @@ func (r *MetadataKodiResource) Delete(ctx context.Context, req resource.DeleteRe
 	// Delete MetadataKodi current value
 	_, err := r.client.MetadataApi.DeleteMetadata(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataKodiResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, metadataKodiResourceName, err)...)
 
 		return
 	}
@@ -242,6 +281,12 @@ func (r *MetadataKodiResource) ImportState(ctx context.Context, req resource.Imp
 	tflog.Trace(ctx, "imported "+metadataKodiResourceName+": "+req.ID)
 }
 
+func (r *MetadataKodiResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewMetadataResource(), "implementation", metadataKodiImplementation),
+	}
+}
+
 func (m *MetadataKodi) write(ctx context.Context, metadata *whisparr.MetadataResource, diags *diag.Diagnostics) {
 	genericMetadata := m.toMetadata()
 	genericMetadata.write(ctx, metadata, diags)
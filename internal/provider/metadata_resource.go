@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -21,8 +23,9 @@ const metadataResourceName = "metadata"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &MetadataResource{}
-	_ resource.ResourceWithImportState = &MetadataResource{}
+	_ resource.Resource                 = &MetadataResource{}
+	_ resource.ResourceWithImportState  = &MetadataResource{}
+	_ resource.ResourceWithUpgradeState = &MetadataResource{}
 )
 
 var metadataFields = helpers.Fields{
@@ -36,12 +39,13 @@ func NewMetadataResource() resource.Resource {
 
 // MetadataResource defines the metadata implementation.
 type MetadataResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // Metadata describes the metadata data model.
 type Metadata struct {
 	Tags                  types.Set    `tfsdk:"tags"`
+	TagLabels             types.Set    `tfsdk:"tag_labels"`
 	Name                  types.String `tfsdk:"name"`
 	ConfigContract        types.String `tfsdk:"config_contract"`
 	Implementation        types.String `tfsdk:"implementation"`
@@ -58,6 +62,7 @@ func (m Metadata) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
 			"tags":                    types.SetType{}.WithElementType(types.Int64Type),
+			"tag_labels":              types.SetType{}.WithElementType(types.StringType),
 			"name":                    types.StringType,
 			"config_contract":         types.StringType,
 			"implementation":          types.StringType,
@@ -77,7 +82,8 @@ func (r *MetadataResource) Metadata(_ context.Context, req resource.MetadataRequ
 
 func (r *MetadataResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "<!-- subcategory:Metadata -->Generic Metadata resource. When possible use a specific resource instead.\nFor more information refer to [Metadata](https://wiki.servarr.com/whisparr/settings#metadata) documentation.",
+		MarkdownDescription: "<!-- subcategory:Metadata -->Generic Metadata resource. When possible use a specific resource instead, existing ones can be moved there with a `moved` block (Terraform 1.8+).\nFor more information refer to [Metadata](https://wiki.servarr.com/whisparr/settings#metadata) documentation.",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -102,6 +108,15 @@ func (r *MetadataResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Metadata ID.",
 				Computed:            true,
@@ -139,6 +154,12 @@ func (r *MetadataResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+func (r *MetadataResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *MetadataResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -155,12 +176,18 @@ func (r *MetadataResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, metadata.TagLabels, &metadata.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new Metadata
 	request := metadata.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.MetadataApi.CreateMetadata(ctx).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, metadataResourceName, err)...)
 
 		return
 	}
@@ -171,6 +198,7 @@ func (r *MetadataResource) Create(ctx context.Context, req resource.CreateReques
 	var state Metadata
 
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = r.client.TagLabels(ctx, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -185,9 +213,11 @@ func (r *MetadataResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Get Metadata current value
-	response, _, err := r.client.MetadataApi.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataApi.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataResourceName, err))
+		if !helpers.RemoveNotFound(ctx, metadataResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, metadataResourceName, err)...)
+		}
 
 		return
 	}
@@ -198,6 +228,7 @@ func (r *MetadataResource) Read(ctx context.Context, req resource.ReadRequest, r
 	var state Metadata
 
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = r.client.TagLabels(ctx, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -211,12 +242,18 @@ func (r *MetadataResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, metadata.TagLabels, &metadata.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update Metadata
 	request := metadata.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.MetadataApi.UpdateMetadata(ctx, strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, metadataResourceName, err)...)
 
 		return
 	}
@@ -227,6 +264,7 @@ func (r *MetadataResource) Update(ctx context.Context, req resource.UpdateReques
 	var state Metadata
 
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = r.client.TagLabels(ctx, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -242,7 +280,7 @@ func (r *MetadataResource) Delete(ctx context.Context, req resource.DeleteReques
 	// Delete Metadata current value
 	_, err := r.client.MetadataApi.DeleteMetadata(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, metadataResourceName, err)...)
 
 		return
 	}
@@ -2,17 +2,18 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -25,8 +26,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &MetadataRoksboxResource{}
-	_ resource.ResourceWithImportState = &MetadataRoksboxResource{}
+	_ resource.Resource                 = &MetadataRoksboxResource{}
+	_ resource.ResourceWithImportState  = &MetadataRoksboxResource{}
+	_ resource.ResourceWithMoveState    = &MetadataRoksboxResource{}
+	_ resource.ResourceWithUpgradeState = &MetadataRoksboxResource{}
 )
 
 func NewMetadataRoksboxResource() resource.Resource {
@@ -35,12 +38,13 @@ func NewMetadataRoksboxResource() resource.Resource {
 
 // MetadataRoksboxResource defines the Roksbox metadata implementation.
 type MetadataRoksboxResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // MetadataRoksbox describes the Roksbox metadata data model.
 type MetadataRoksbox struct {
 	Tags          types.Set    `tfsdk:"tags"`
+	TagLabels     types.Set    `tfsdk:"tag_labels"`
 	Name          types.String `tfsdk:"name"`
 	ID            types.Int64  `tfsdk:"id"`
 	Enable        types.Bool   `tfsdk:"enable"`
@@ -51,6 +55,7 @@ type MetadataRoksbox struct {
 func (m MetadataRoksbox) toMetadata() *Metadata {
 	return &Metadata{
 		Tags:           m.Tags,
+		TagLabels:      m.TagLabels,
 		Name:           m.Name,
 		ID:             m.ID,
 		Enable:         m.Enable,
@@ -65,6 +70,7 @@ func (m *MetadataRoksbox) fromMetadata(metadata *Metadata) {
 	m.ID = metadata.ID
 	m.Name = metadata.Name
 	m.Tags = metadata.Tags
+	m.TagLabels = metadata.TagLabels
 	m.Enable = metadata.Enable
 	m.MovieMetadata = metadata.MovieMetadata
 	m.MovieImages = metadata.MovieImages
@@ -77,6 +83,7 @@ func (r *MetadataRoksboxResource) Metadata(_ context.Context, req resource.Metad
 func (r *MetadataRoksboxResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Metadata -->Metadata Roksbox resource.\nFor more information refer to [Metadata](https://wiki.servarr.com/whisparr/settings#metadata) and [ROKSBOX](https://wiki.servarr.com/whisparr/supported#roksboxmetadata).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -93,6 +100,15 @@ func (r *MetadataRoksboxResource) Schema(_ context.Context, _ resource.SchemaReq
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Metadata ID.",
 				Computed:            true,
@@ -113,6 +129,12 @@ func (r *MetadataRoksboxResource) Schema(_ context.Context, _ resource.SchemaReq
 	}
 }
 
+func (r *MetadataRoksboxResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *MetadataRoksboxResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -129,12 +151,18 @@ func (r *MetadataRoksboxResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, metadata.TagLabels, &metadata.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new MetadataRoksbox
 	request := metadata.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.MetadataApi.CreateMetadata(ctx).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataRoksboxResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, metadataRoksboxResourceName, err)...)
 
 		return
 	}
@@ -142,6 +170,7 @@ func (r *MetadataRoksboxResource) Create(ctx context.Context, req resource.Creat
 	tflog.Trace(ctx, "created "+metadataRoksboxResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	metadata.write(ctx, response, &resp.Diagnostics)
+	metadata.TagLabels = r.client.TagLabels(ctx, metadata.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
 
@@ -156,9 +185,11 @@ func (r *MetadataRoksboxResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Get MetadataRoksbox current value
-	response, _, err := r.client.MetadataApi.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataApi.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataRoksboxResourceName, err))
+		if !helpers.RemoveNotFound(ctx, metadataRoksboxResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, metadataRoksboxResourceName, err)...)
+		}
 
 		return
 	}
@@ -166,6 +197,7 @@ func (r *MetadataRoksboxResource) Read(ctx context.Context, req resource.ReadReq
 	tflog.Trace(ctx, "read "+metadataRoksboxResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	metadata.write(ctx, response, &resp.Diagnostics)
+	metadata.TagLabels = r.client.TagLabels(ctx, metadata.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
 
@@ -179,12 +211,18 @@ func (r *MetadataRoksboxResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, metadata.TagLabels, &metadata.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update MetadataRoksbox
 	request := metadata.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.MetadataApi.UpdateMetadata(ctx, strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, fmt.Sprintf("Unable to update "+metadataRoksboxResourceName+", got error: %s", err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, metadataRoksboxResourceName, err)...)
 
 		return
 	}
@@ -192,6 +230,7 @@ func (r *MetadataRoksboxResource) Update(ctx context.Context, req resource.Updat
 	tflog.Trace(ctx, "updated "+metadataRoksboxResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	metadata.write(ctx, response, &resp.Diagnostics)
+	metadata.TagLabels = r.client.TagLabels(ctx, metadata.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
 
@@ -207,7 +246,7 @@ func (r *MetadataRoksboxResource) Delete(ctx context.Context, req resource.Delet
 	// Delete MetadataRoksbox current value
 	_, err := r.client.MetadataApi.DeleteMetadata(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataRoksboxResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, metadataRoksboxResourceName, err)...)
 
 		return
 	}
@@ -221,6 +260,12 @@ func (r *MetadataRoksboxResource) ImportState(ctx context.Context, req resource.
 	tflog.Trace(ctx, "imported "+metadataRoksboxResourceName+": "+req.ID)
 }
 
+func (r *MetadataRoksboxResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewMetadataResource(), "implementation", metadataRoksboxImplementation),
+	}
+}
+
 func (m *MetadataRoksbox) write(ctx context.Context, metadata *whisparr.MetadataResource, diags *diag.Diagnostics) {
 	genericMetadata := m.toMetadata()
 	genericMetadata.write(ctx, metadata, diags)
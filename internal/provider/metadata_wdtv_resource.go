@@ -2,17 +2,18 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -25,8 +26,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &MetadataWdtvResource{}
-	_ resource.ResourceWithImportState = &MetadataWdtvResource{}
+	_ resource.Resource                 = &MetadataWdtvResource{}
+	_ resource.ResourceWithImportState  = &MetadataWdtvResource{}
+	_ resource.ResourceWithMoveState    = &MetadataWdtvResource{}
+	_ resource.ResourceWithUpgradeState = &MetadataWdtvResource{}
 )
 
 func NewMetadataWdtvResource() resource.Resource {
@@ -35,12 +38,13 @@ func NewMetadataWdtvResource() resource.Resource {
 
 // MetadataWdtvResource defines the Wdtv metadata implementation.
 type MetadataWdtvResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // MetadataWdtv describes the Wdtv metadata data model.
 type MetadataWdtv struct {
 	Tags          types.Set    `tfsdk:"tags"`
+	TagLabels     types.Set    `tfsdk:"tag_labels"`
 	Name          types.String `tfsdk:"name"`
 	ID            types.Int64  `tfsdk:"id"`
 	Enable        types.Bool   `tfsdk:"enable"`
@@ -51,6 +55,7 @@ type MetadataWdtv struct {
 func (m MetadataWdtv) toMetadata() *Metadata {
 	return &Metadata{
 		Tags:           m.Tags,
+		TagLabels:      m.TagLabels,
 		Name:           m.Name,
 		ID:             m.ID,
 		Enable:         m.Enable,
@@ -65,6 +70,7 @@ func (m *MetadataWdtv) fromMetadata(metadata *Metadata) {
 	m.ID = metadata.ID
 	m.Name = metadata.Name
 	m.Tags = metadata.Tags
+	m.TagLabels = metadata.TagLabels
 	m.Enable = metadata.Enable
 	m.MovieMetadata = metadata.MovieMetadata
 	m.MovieImages = metadata.MovieImages
@@ -77,6 +83,7 @@ func (r *MetadataWdtvResource) Metadata(_ context.Context, req resource.Metadata
 func (r *MetadataWdtvResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Metadata -->Metadata Wdtv resource.\nFor more information refer to [Metadata](https://wiki.servarr.com/whisparr/settings#metadata) and [WDTV](https://wiki.servarr.com/whisparr/supported#wdtvmetadata).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -93,6 +100,15 @@ func (r *MetadataWdtvResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Metadata ID.",
 				Computed:            true,
@@ -113,6 +129,12 @@ func (r *MetadataWdtvResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func (r *MetadataWdtvResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *MetadataWdtvResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -129,12 +151,18 @@ func (r *MetadataWdtvResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, metadata.TagLabels, &metadata.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new MetadataWdtv
 	request := metadata.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.MetadataApi.CreateMetadata(ctx).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataWdtvResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, metadataWdtvResourceName, err)...)
 
 		return
 	}
@@ -142,6 +170,7 @@ func (r *MetadataWdtvResource) Create(ctx context.Context, req resource.CreateRe
 	tflog.Trace(ctx, "created "+metadataWdtvResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	metadata.write(ctx, response, &resp.Diagnostics)
+	metadata.TagLabels = r.client.TagLabels(ctx, metadata.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
 
@@ -156,9 +185,11 @@ func (r *MetadataWdtvResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get MetadataWdtv current value
-	response, _, err := r.client.MetadataApi.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataApi.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataWdtvResourceName, err))
+		if !helpers.RemoveNotFound(ctx, metadataWdtvResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, metadataWdtvResourceName, err)...)
+		}
 
 		return
 	}
@@ -166,6 +197,7 @@ func (r *MetadataWdtvResource) Read(ctx context.Context, req resource.ReadReques
 	tflog.Trace(ctx, "read "+metadataWdtvResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	metadata.write(ctx, response, &resp.Diagnostics)
+	metadata.TagLabels = r.client.TagLabels(ctx, metadata.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
 
@@ -179,12 +211,18 @@ func (r *MetadataWdtvResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, metadata.TagLabels, &metadata.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update MetadataWdtv
 	request := metadata.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.MetadataApi.UpdateMetadata(ctx, strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, fmt.Sprintf("Unable to update "+metadataWdtvResourceName+", got error: %s", err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, metadataWdtvResourceName, err)...)
 
 		return
 	}
@@ -192,6 +230,7 @@ func (r *MetadataWdtvResource) Update(ctx context.Context, req resource.UpdateRe
 	tflog.Trace(ctx, "updated "+metadataWdtvResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	metadata.write(ctx, response, &resp.Diagnostics)
+	metadata.TagLabels = r.client.TagLabels(ctx, metadata.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
 
@@ -207,7 +246,7 @@ func (r *MetadataWdtvResource) Delete(ctx context.Context, req resource.DeleteRe
 	// Delete MetadataWdtv current value
 	_, err := r.client.MetadataApi.DeleteMetadata(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataWdtvResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, metadataWdtvResourceName, err)...)
 
 		return
 	}
@@ -221,6 +260,12 @@ func (r *MetadataWdtvResource) ImportState(ctx context.Context, req resource.Imp
 	tflog.Trace(ctx, "imported "+metadataWdtvResourceName+": "+req.ID)
 }
 
+func (r *MetadataWdtvResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewMetadataResource(), "implementation", metadataWdtvImplementation),
+	}
+}
+
 func (m *MetadataWdtv) write(ctx context.Context, metadata *whisparr.MetadataResource, diags *diag.Diagnostics) {
 	genericMetadata := m.toMetadata()
 	genericMetadata.write(ctx, metadata, diags)
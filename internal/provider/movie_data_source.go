@@ -24,7 +24,7 @@ func NewMovieDataSource() datasource.DataSource {
 
 // MovieDataSource defines the movie implementation.
 type MovieDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *MovieDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -101,6 +101,11 @@ func (d *MovieDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"genres": schema.SetAttribute{
 				MarkdownDescription: "List genres.",
 				Computed:            true,
@@ -142,12 +147,13 @@ func (d *MovieDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	// Get movies current value
 	response, _, err := d.client.MovieApi.ListMovie(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, movieDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, movieDataSourceName, err)...)
 
 		return
 	}
 
 	data.find(ctx, data.TMDBID.ValueInt64(), response, &resp.Diagnostics)
+	data.TagLabels = d.client.TagLabels(ctx, data.Tags, &resp.Diagnostics)
 	tflog.Trace(ctx, "read "+tagDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
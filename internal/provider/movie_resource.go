@@ -7,6 +7,7 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -24,8 +25,9 @@ const movieResourceName = "movie"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &MovieResource{}
-	_ resource.ResourceWithImportState = &MovieResource{}
+	_ resource.Resource                 = &MovieResource{}
+	_ resource.ResourceWithImportState  = &MovieResource{}
+	_ resource.ResourceWithUpgradeState = &MovieResource{}
 )
 
 func NewMovieResource() resource.Resource {
@@ -34,13 +36,14 @@ func NewMovieResource() resource.Resource {
 
 // MovieResource defines the movie implementation.
 type MovieResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // Movie describes the movie data model.
 type Movie struct {
 	Genres              types.Set    `tfsdk:"genres"`
 	Tags                types.Set    `tfsdk:"tags"`
+	TagLabels           types.Set    `tfsdk:"tag_labels"`
 	OriginalLanguage    types.Object `tfsdk:"original_language"`
 	Title               types.String `tfsdk:"title"`
 	Path                types.String `tfsdk:"path"`
@@ -84,6 +87,7 @@ func (m Movie) getType() attr.Type {
 		map[string]attr.Type{
 			"genres":               types.SetType{}.WithElementType(types.StringType),
 			"tags":                 types.SetType{}.WithElementType(types.Int64Type),
+			"tag_labels":           types.SetType{}.WithElementType(types.StringType),
 			"original_language":    QualityLanguage{}.getType(),
 			"title":                types.StringType,
 			"path":                 types.StringType,
@@ -110,6 +114,7 @@ func (r *MovieResource) Metadata(_ context.Context, req resource.MetadataRequest
 func (r *MovieResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Movies -->Movie resource.\nFor more information refer to [Movies](https://wiki.servarr.com/whisparr/library#movies) documentation.",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"monitored": schema.BoolAttribute{
 				MarkdownDescription: "Monitored flag.",
@@ -184,6 +189,15 @@ func (r *MovieResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"genres": schema.SetAttribute{
 				MarkdownDescription: "List genres.",
 				Computed:            true,
@@ -198,6 +212,12 @@ func (r *MovieResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+func (r *MovieResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *MovieResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -214,6 +234,12 @@ func (r *MovieResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, movie.TagLabels, &movie.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new Movie
 	request := movie.read(ctx, &resp.Diagnostics)
 	// TODO: can parametrize AddMovieOptions
@@ -222,7 +248,7 @@ func (r *MovieResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	response, _, err := r.client.MovieApi.CreateMovie(ctx).MovieResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, movieResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Create, movieResourceName, err)...)
 
 		return
 	}
@@ -230,6 +256,7 @@ func (r *MovieResource) Create(ctx context.Context, req resource.CreateRequest,
 	tflog.Trace(ctx, "created movie: "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	movie.write(ctx, response, &resp.Diagnostics)
+	movie.TagLabels = r.client.TagLabels(ctx, movie.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &movie)...)
 }
 
@@ -244,9 +271,11 @@ func (r *MovieResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Get movie current value
-	response, _, err := r.client.MovieApi.GetMovieById(ctx, int32(movie.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MovieApi.GetMovieById(ctx, int32(movie.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, movieResourceName, err))
+		if !helpers.RemoveNotFound(ctx, movieResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, movieResourceName, err)...)
+		}
 
 		return
 	}
@@ -254,6 +283,7 @@ func (r *MovieResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	tflog.Trace(ctx, "read "+movieResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	movie.write(ctx, response, &resp.Diagnostics)
+	movie.TagLabels = r.client.TagLabels(ctx, movie.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &movie)...)
 }
 
@@ -267,12 +297,18 @@ func (r *MovieResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, movie.TagLabels, &movie.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update Movie
 	request := movie.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.MovieApi.UpdateMovie(ctx, fmt.Sprint(request.GetId())).MovieResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, movieResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Update, movieResourceName, err)...)
 
 		return
 	}
@@ -280,6 +316,7 @@ func (r *MovieResource) Update(ctx context.Context, req resource.UpdateRequest,
 	tflog.Trace(ctx, "updated "+movieResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	movie.write(ctx, response, &resp.Diagnostics)
+	movie.TagLabels = r.client.TagLabels(ctx, movie.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &movie)...)
 }
 
@@ -295,7 +332,7 @@ func (r *MovieResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	// Delete movie current value
 	_, err := r.client.MovieApi.DeleteMovie(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, movieResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Delete, movieResourceName, err)...)
 
 		return
 	}
@@ -2,14 +2,20 @@ package provider
 
 import (
 	"context"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/exp/slices"
 )
 
 const moviesDataSourceName = "movies"
@@ -23,13 +29,44 @@ func NewMoviesDataSource() datasource.DataSource {
 
 // MoviesDataSource defines the movies implementation.
 type MoviesDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // Movies describes the movies data model.
 type Movies struct {
-	Movies types.Set    `tfsdk:"movies"`
-	ID     types.String `tfsdk:"id"`
+	Movies           types.Set    `tfsdk:"movies"`
+	IDs              types.Set    `tfsdk:"ids"`
+	Tags             types.Set    `tfsdk:"tags"`
+	TMDBIDs          types.Set    `tfsdk:"tmdb_ids"`
+	YearRange        types.Object `tfsdk:"year_range"`
+	Studio           types.String `tfsdk:"studio"`
+	TitleRegex       types.String `tfsdk:"title_regex"`
+	RootFolderPath   types.String `tfsdk:"root_folder_path"`
+	ID               types.String `tfsdk:"id"`
+	QualityProfileID types.Int64  `tfsdk:"quality_profile_id"`
+	Monitored        types.Bool   `tfsdk:"monitored"`
+	HasFile          types.Bool   `tfsdk:"has_file"`
+	IDsOnly          types.Bool   `tfsdk:"ids_only"`
+}
+
+// MovieYearRange is part of Movies.
+type MovieYearRange struct {
+	Min types.Int64 `tfsdk:"min"`
+	Max types.Int64 `tfsdk:"max"`
+}
+
+// movieFilter contains the parsed filters of the movies data source.
+type movieFilter struct {
+	titleRegex       *regexp.Regexp
+	monitored        *bool
+	hasFile          *bool
+	studio           string
+	rootFolderPath   string
+	tags             []int64
+	tmdbIDs          []int64
+	qualityProfileID int64
+	minYear          int64
+	maxYear          int64
 }
 
 func (d *MoviesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -45,6 +82,63 @@ func (d *MoviesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Filter by monitored flag.",
+				Optional:            true,
+			},
+			"has_file": schema.BoolAttribute{
+				MarkdownDescription: "Filter by file presence.",
+				Optional:            true,
+			},
+			"ids_only": schema.BoolAttribute{
+				MarkdownDescription: "Only return the IDs of the matching movies, leaving `movies` empty.",
+				Optional:            true,
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Filter by quality profile ID.",
+				Optional:            true,
+			},
+			"studio": schema.StringAttribute{
+				MarkdownDescription: "Filter by studio.",
+				Optional:            true,
+			},
+			"title_regex": schema.StringAttribute{
+				MarkdownDescription: "Filter by regular expression matching the title.",
+				Optional:            true,
+			},
+			"root_folder_path": schema.StringAttribute{
+				MarkdownDescription: "Filter by root folder path.",
+				Optional:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Filter by tags. Movies must have all the given tags.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"tmdb_ids": schema.SetAttribute{
+				MarkdownDescription: "Filter by TMDB IDs.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"year_range": schema.SingleNestedAttribute{
+				MarkdownDescription: "Filter by year range, bounds included.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"min": schema.Int64Attribute{
+						MarkdownDescription: "Minimum year.",
+						Optional:            true,
+					},
+					"max": schema.Int64Attribute{
+						MarkdownDescription: "Maximum year.",
+						Optional:            true,
+					},
+				},
+			},
+			"ids": schema.SetAttribute{
+				MarkdownDescription: "Matching movie ID list.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
 			"movies": schema.SetNestedAttribute{
 				MarkdownDescription: "Movie list.",
 				Computed:            true,
@@ -115,6 +209,11 @@ func (d *MoviesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"tag_labels": schema.SetAttribute{
+							MarkdownDescription: "List of associated tag labels.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 						"genres": schema.SetAttribute{
 							MarkdownDescription: "List genres.",
 							Computed:            true,
@@ -147,23 +246,121 @@ func (d *MoviesDataSource) Configure(ctx context.Context, req datasource.Configu
 	}
 }
 
-func (d *MoviesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *MoviesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Movies
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := data.filter(ctx, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get movies current value
 	response, _, err := d.client.MovieApi.ListMovie(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, moviesDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.List, moviesDataSourceName, err)...)
 
 		return
 	}
 
 	tflog.Trace(ctx, "read "+moviesDataSourceName)
+	labels := d.client.TagLabelMap(ctx, &resp.Diagnostics)
+
 	// Map response body to resource schema attribute
-	movies := make([]Movie, len(response))
-	for i, m := range response {
-		movies[i].write(ctx, m, &resp.Diagnostics)
+	movies := []Movie{}
+	ids := []int64{}
+
+	for _, m := range response {
+		if !filter.match(m) {
+			continue
+		}
+
+		ids = append(ids, int64(m.GetId()))
+
+		if data.IDsOnly.ValueBool() {
+			continue
+		}
+
+		movie := Movie{}
+		movie.write(ctx, m, &resp.Diagnostics)
+		movie.TagLabels = helpers.MapTagLabels(ctx, movie.Tags, labels, &resp.Diagnostics)
+		movies = append(movies, movie)
 	}
 
-	movieList, diags := types.SetValueFrom(ctx, Movie{}.getType(), movies)
+	var diags diag.Diagnostics
+
+	data.Movies, diags = types.SetValueFrom(ctx, Movie{}.getType(), movies)
+	resp.Diagnostics.Append(diags...)
+	data.IDs, diags = types.SetValueFrom(ctx, types.Int64Type, ids)
 	resp.Diagnostics.Append(diags...)
-	resp.Diagnostics.Append(resp.State.Set(ctx, Movies{Movies: movieList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+	data.ID = types.StringValue(strconv.Itoa(len(ids)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (m *Movies) filter(ctx context.Context, diags *diag.Diagnostics) *movieFilter {
+	filter := &movieFilter{
+		studio:           m.Studio.ValueString(),
+		rootFolderPath:   m.RootFolderPath.ValueString(),
+		qualityProfileID: m.QualityProfileID.ValueInt64(),
+		monitored:        m.Monitored.ValueBoolPointer(),
+		hasFile:          m.HasFile.ValueBoolPointer(),
+	}
+
+	if m.TitleRegex.ValueString() != "" {
+		regex, err := regexp.Compile(m.TitleRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("title_regex"), helpers.DataSourceError, "Invalid title regex: "+err.Error())
+
+			return nil
+		}
+
+		filter.titleRegex = regex
+	}
+
+	if !m.YearRange.IsNull() {
+		yearRange := MovieYearRange{}
+		diags.Append(m.YearRange.As(ctx, &yearRange, basetypes.ObjectAsOptions{})...)
+		filter.minYear = yearRange.Min.ValueInt64()
+		filter.maxYear = yearRange.Max.ValueInt64()
+	}
+
+	diags.Append(m.Tags.ElementsAs(ctx, &filter.tags, true)...)
+	diags.Append(m.TMDBIDs.ElementsAs(ctx, &filter.tmdbIDs, true)...)
+
+	return filter
+}
+
+// match returns true when the movie satisfies all the filters.
+func (f *movieFilter) match(movie *whisparr.MovieResource) bool {
+	switch {
+	case f.monitored != nil && movie.GetMonitored() != *f.monitored,
+		f.hasFile != nil && movie.GetHasFile() != *f.hasFile,
+		f.qualityProfileID != 0 && int64(movie.GetQualityProfileId()) != f.qualityProfileID,
+		f.studio != "" && movie.GetStudio() != f.studio,
+		f.rootFolderPath != "" && strings.TrimSuffix(movie.GetRootFolderPath(), "/") != strings.TrimSuffix(f.rootFolderPath, "/"),
+		f.minYear != 0 && int64(movie.GetYear()) < f.minYear,
+		f.maxYear != 0 && int64(movie.GetYear()) > f.maxYear,
+		f.titleRegex != nil && !f.titleRegex.MatchString(movie.GetTitle()),
+		len(f.tmdbIDs) != 0 && !slices.Contains(f.tmdbIDs, int64(movie.GetTmdbId())):
+		return false
+	}
+
+	tags := make([]int64, len(movie.GetTags()))
+	for i, t := range movie.GetTags() {
+		tags[i] = int64(*t)
+	}
+
+	for _, tag := range f.tags {
+		if !slices.Contains(tags, tag) {
+			return false
+		}
+	}
+
+	return true
 }
@@ -26,6 +26,15 @@ func TestAccMoviesDataSource(t *testing.T) {
 					resource.TestCheckTypeSetElemNestedAttrs("data.whisparr_movies.test", "movies.*", map[string]string{"title": "Kim Kardashian, Superstar"}),
 				),
 			},
+			// Filter testing
+			{
+				Config: testAccMovieResourceConfig("Kim Kardashian, Superstar", "Kim_Kardashian_Superstar_2007", 45323) + testAccMoviesDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.whisparr_movies.test", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.whisparr_movies.test", "movies.#", "0"),
+					resource.TestCheckResourceAttr("data.whisparr_movies.empty", "ids.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -35,3 +44,21 @@ data "whisparr_movies" "test" {
 	depends_on = [whisparr_movie.test]
 }
 `
+
+const testAccMoviesDataSourceFilterConfig = `
+data "whisparr_movies" "test" {
+	monitored = false
+	ids_only = true
+	title_regex = "^Kim Kardashian"
+	tmdb_ids = [whisparr_movie.test.tmdb_id]
+	year_range = {
+		min = 2000
+		max = 2010
+	}
+}
+
+data "whisparr_movies" "empty" {
+	monitored = true
+	tmdb_ids = [whisparr_movie.test.tmdb_id]
+}
+`
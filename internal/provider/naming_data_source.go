@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,7 +20,7 @@ func NewNamingDataSource() datasource.DataSource {
 
 // NamingDataSource defines the naming implementation.
 type NamingDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *NamingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -79,7 +78,7 @@ func (d *NamingDataSource) Read(ctx context.Context, _ datasource.ReadRequest, r
 	// Get naming current value
 	response, _, err := d.client.NamingConfigApi.GetNamingConfig(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, namingDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, namingDataSourceName, err)...)
 
 		return
 	}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
+	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const namingExamplesDataSourceName = "naming_examples"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NamingExamplesDataSource{}
+
+func NewNamingExamplesDataSource() datasource.DataSource {
+	return &NamingExamplesDataSource{}
+}
+
+// NamingExamplesDataSource defines the naming examples implementation.
+type NamingExamplesDataSource struct {
+	client *helpers.Client
+}
+
+// NamingExamples describes the naming examples data model.
+type NamingExamples struct {
+	ColonReplacementFormat   types.String `tfsdk:"colon_replacement_format"`
+	StandardMovieFormat      types.String `tfsdk:"standard_movie_format"`
+	MovieFolderFormat        types.String `tfsdk:"movie_folder_format"`
+	MovieExample             types.String `tfsdk:"movie_example"`
+	MovieFolderExample       types.String `tfsdk:"movie_folder_example"`
+	ID                       types.Int64  `tfsdk:"id"`
+	IncludeQuality           types.Bool   `tfsdk:"include_quality"`
+	RenameMovies             types.Bool   `tfsdk:"rename_movies"`
+	ReplaceIllegalCharacters types.Bool   `tfsdk:"replace_illegal_characters"`
+	ReplaceSpaces            types.Bool   `tfsdk:"replace_spaces"`
+}
+
+// namingExamplesResponse is the naming examples payload, not modeled by the SDK.
+type namingExamplesResponse struct {
+	MovieExample       string `json:"movieExample"`
+	MovieFolderExample string `json:"movieFolderExample"`
+}
+
+func (d *NamingExamplesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + namingExamplesDataSourceName
+}
+
+func (d *NamingExamplesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Media Management -->Example file and folder names rendered for candidate [Naming](../resources/naming) formats.\nUnset values default to the current naming configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Naming ID.",
+				Computed:            true,
+			},
+			"include_quality": schema.BoolAttribute{
+				MarkdownDescription: "Include quality in file name.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"rename_movies": schema.BoolAttribute{
+				MarkdownDescription: "Whisparr will use the existing file name if false.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"replace_illegal_characters": schema.BoolAttribute{
+				MarkdownDescription: "Replace illegal characters. They will be removed if false.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"replace_spaces": schema.BoolAttribute{
+				MarkdownDescription: "Replace spaces.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"colon_replacement_format": schema.StringAttribute{
+				MarkdownDescription: "Change how Whisparr handles colon replacement. Valid values are: 'delete', 'dash', 'spaceDash', and 'spaceDashSpace'.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("delete", "dash", "spaceDash", "spaceDashSpace"),
+				},
+			},
+			"movie_folder_format": schema.StringAttribute{
+				MarkdownDescription: "Movie folder format.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"standard_movie_format": schema.StringAttribute{
+				MarkdownDescription: "Standard movie format.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"movie_example": schema.StringAttribute{
+				MarkdownDescription: "Rendered example movie file name.",
+				Computed:            true,
+			},
+			"movie_folder_example": schema.StringAttribute{
+				MarkdownDescription: "Rendered example movie folder name.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *NamingExamplesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *NamingExamplesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *NamingExamples
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get naming current value to fill the missing fields
+	naming, _, err := d.client.NamingConfigApi.GetNamingConfig(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, namingResourceName, err)...)
+
+		return
+	}
+
+	data.merge(naming)
+
+	// Get naming examples
+	response, err := d.client.NamingConfigApi.GetNamingConfigExamples(ctx).
+		Id(naming.GetId()).
+		RenameMovies(naming.GetRenameMovies()).
+		ReplaceIllegalCharacters(naming.GetReplaceIllegalCharacters()).
+		ColonReplacementFormat(naming.GetColonReplacementFormat()).
+		StandardMovieFormat(naming.GetStandardMovieFormat()).
+		MovieFolderFormat(naming.GetMovieFolderFormat()).
+		IncludeQuality(naming.GetIncludeQuality()).
+		ReplaceSpaces(naming.GetReplaceSpaces()).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, namingExamplesDataSourceName, err)...)
+
+		return
+	}
+
+	defer response.Body.Close()
+
+	var examples namingExamplesResponse
+	if err := json.NewDecoder(response.Body).Decode(&examples); err != nil {
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, namingExamplesDataSourceName, err)...)
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+namingExamplesDataSourceName)
+	// Map response body to resource schema attribute
+	data.MovieExample = types.StringValue(examples.MovieExample)
+	data.MovieFolderExample = types.StringValue(examples.MovieFolderExample)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// merge overrides the current naming with the configured fields and writes back the result.
+func (n *NamingExamples) merge(naming *whisparr.NamingConfigResource) {
+	if !n.IncludeQuality.IsNull() {
+		naming.SetIncludeQuality(n.IncludeQuality.ValueBool())
+	}
+
+	if !n.RenameMovies.IsNull() {
+		naming.SetRenameMovies(n.RenameMovies.ValueBool())
+	}
+
+	if !n.ReplaceIllegalCharacters.IsNull() {
+		naming.SetReplaceIllegalCharacters(n.ReplaceIllegalCharacters.ValueBool())
+	}
+
+	if !n.ReplaceSpaces.IsNull() {
+		naming.SetReplaceSpaces(n.ReplaceSpaces.ValueBool())
+	}
+
+	if !n.ColonReplacementFormat.IsNull() {
+		naming.SetColonReplacementFormat(whisparr.ColonReplacementFormat(n.ColonReplacementFormat.ValueString()))
+	}
+
+	if !n.StandardMovieFormat.IsNull() {
+		naming.SetStandardMovieFormat(n.StandardMovieFormat.ValueString())
+	}
+
+	if !n.MovieFolderFormat.IsNull() {
+		naming.SetMovieFolderFormat(n.MovieFolderFormat.ValueString())
+	}
+
+	n.ID = types.Int64Value(int64(naming.GetId()))
+	n.IncludeQuality = types.BoolValue(naming.GetIncludeQuality())
+	n.RenameMovies = types.BoolValue(naming.GetRenameMovies())
+	n.ReplaceIllegalCharacters = types.BoolValue(naming.GetReplaceIllegalCharacters())
+	n.ReplaceSpaces = types.BoolValue(naming.GetReplaceSpaces())
+	n.ColonReplacementFormat = types.StringValue(string(naming.GetColonReplacementFormat()))
+	n.StandardMovieFormat = types.StringValue(naming.GetStandardMovieFormat())
+	n.MovieFolderFormat = types.StringValue(naming.GetMovieFolderFormat())
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNamingExamplesDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccNamingExamplesDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccNamingExamplesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.whisparr_naming_examples.test", "id"),
+					resource.TestCheckResourceAttr("data.whisparr_naming_examples.test", "rename_movies", "true"),
+					resource.TestCheckResourceAttrSet("data.whisparr_naming_examples.test", "movie_example"),
+					resource.TestCheckResourceAttrSet("data.whisparr_naming_examples.test", "movie_folder_example"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNamingExamplesDataSourceConfig = `
+data "whisparr_naming_examples" "test" {
+	rename_movies = true
+	standard_movie_format = "{Movie Title} ({Release Year}) {Quality Full}"
+	movie_folder_format = "{Movie Title} ({Release Year})"
+}
+`
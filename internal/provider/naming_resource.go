@@ -31,7 +31,7 @@ func NewNamingResource() resource.Resource {
 
 // NamingResource defines the naming implementation.
 type NamingResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // Naming describes the naming data model.
@@ -114,7 +114,7 @@ func (r *NamingResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Init call if we remove this it the very first update on a brand new instance will fail
 	if _, _, err := r.client.NamingConfigApi.GetNamingConfig(ctx).Execute(); err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, namingResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, namingResourceName, err)...)
 
 		return
 	}
@@ -126,7 +126,7 @@ func (r *NamingResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Create new Naming
 	response, _, err := r.client.NamingConfigApi.UpdateNamingConfig(ctx, strconv.Itoa(int(request.GetId()))).NamingConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, namingResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Create, namingResourceName, err)...)
 
 		return
 	}
@@ -150,7 +150,7 @@ func (r *NamingResource) Read(ctx context.Context, req resource.ReadRequest, res
 	// Get naming current value
 	response, _, err := r.client.NamingConfigApi.GetNamingConfig(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, namingResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, namingResourceName, err)...)
 
 		return
 	}
@@ -177,7 +177,7 @@ func (r *NamingResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Update Naming
 	response, _, err := r.client.NamingConfigApi.UpdateNamingConfig(ctx, strconv.Itoa(int(request.GetId()))).NamingConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, namingResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Update, namingResourceName, err)...)
 
 		return
 	}
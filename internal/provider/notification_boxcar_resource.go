@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +26,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationBoxcarResource{}
-	_ resource.ResourceWithImportState = &NotificationBoxcarResource{}
+	_ resource.Resource                 = &NotificationBoxcarResource{}
+	_ resource.ResourceWithImportState  = &NotificationBoxcarResource{}
+	_ resource.ResourceWithMoveState    = &NotificationBoxcarResource{}
+	_ resource.ResourceWithUpgradeState = &NotificationBoxcarResource{}
 )
 
 func NewNotificationBoxcarResource() resource.Resource {
@@ -34,12 +38,13 @@ func NewNotificationBoxcarResource() resource.Resource {
 
 // NotificationBoxcarResource defines the notification implementation.
 type NotificationBoxcarResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // NotificationBoxcar describes the notification data model.
 type NotificationBoxcar struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	Token                       types.String `tfsdk:"token"`
 	Name                        types.String `tfsdk:"name"`
 	ID                          types.Int64  `tfsdk:"id"`
@@ -57,6 +62,7 @@ type NotificationBoxcar struct {
 func (n NotificationBoxcar) toNotification() *Notification {
 	return &Notification{
 		Tags:                        n.Tags,
+		TagLabels:                   n.TagLabels,
 		Token:                       n.Token,
 		Name:                        n.Name,
 		ID:                          n.ID,
@@ -76,6 +82,7 @@ func (n NotificationBoxcar) toNotification() *Notification {
 
 func (n *NotificationBoxcar) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.Token = notification.Token
 	n.Name = notification.Name
 	n.ID = notification.ID
@@ -97,6 +104,7 @@ func (r *NotificationBoxcarResource) Metadata(_ context.Context, req resource.Me
 func (r *NotificationBoxcarResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Boxcar resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect) and [Boxcar](https://wiki.servarr.com/whisparr/supported#boxcar).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
@@ -152,6 +160,15 @@ func (r *NotificationBoxcarResource) Schema(_ context.Context, _ resource.Schema
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -169,6 +186,12 @@ func (r *NotificationBoxcarResource) Schema(_ context.Context, _ resource.Schema
 	}
 }
 
+func (r *NotificationBoxcarResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationBoxcarResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -185,12 +208,18 @@ func (r *NotificationBoxcarResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationBoxcar
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationBoxcarResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationBoxcarResourceName, err)...)
 
 		return
 	}
@@ -198,6 +227,7 @@ func (r *NotificationBoxcarResource) Create(ctx context.Context, req resource.Cr
 	tflog.Trace(ctx, "created "+notificationBoxcarResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -212,9 +242,11 @@ func (r *NotificationBoxcarResource) Read(ctx context.Context, req resource.Read
 	}
 
 	// Get NotificationBoxcar current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationBoxcarResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationBoxcarResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationBoxcarResourceName, err)...)
+		}
 
 		return
 	}
@@ -222,6 +254,7 @@ func (r *NotificationBoxcarResource) Read(ctx context.Context, req resource.Read
 	tflog.Trace(ctx, "read "+notificationBoxcarResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -235,12 +268,18 @@ func (r *NotificationBoxcarResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationBoxcar
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationBoxcarResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationBoxcarResourceName, err)...)
 
 		return
 	}
@@ -248,6 +287,7 @@ func (r *NotificationBoxcarResource) Update(ctx context.Context, req resource.Up
 	tflog.Trace(ctx, "updated "+notificationBoxcarResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -263,7 +303,7 @@ func (r *NotificationBoxcarResource) Delete(ctx context.Context, req resource.De
 	// Delete NotificationBoxcar current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationBoxcarResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationBoxcarResourceName, err)...)
 
 		return
 	}
@@ -277,6 +317,12 @@ func (r *NotificationBoxcarResource) ImportState(ctx context.Context, req resour
 	tflog.Trace(ctx, "imported "+notificationBoxcarResourceName+": "+req.ID)
 }
 
+func (r *NotificationBoxcarResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewNotificationResource(), "implementation", notificationBoxcarImplementation),
+	}
+}
+
 func (n *NotificationBoxcar) write(ctx context.Context, notification *whisparr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
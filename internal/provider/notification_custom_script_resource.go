@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +26,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationCustomScriptResource{}
-	_ resource.ResourceWithImportState = &NotificationCustomScriptResource{}
+	_ resource.Resource                 = &NotificationCustomScriptResource{}
+	_ resource.ResourceWithImportState  = &NotificationCustomScriptResource{}
+	_ resource.ResourceWithMoveState    = &NotificationCustomScriptResource{}
+	_ resource.ResourceWithUpgradeState = &NotificationCustomScriptResource{}
 )
 
 func NewNotificationCustomScriptResource() resource.Resource {
@@ -34,12 +38,13 @@ func NewNotificationCustomScriptResource() resource.Resource {
 
 // NotificationCustomScriptResource defines the notification implementation.
 type NotificationCustomScriptResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // NotificationCustomScript describes the notification data model.
 type NotificationCustomScript struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	Arguments                   types.String `tfsdk:"arguments"`
 	Path                        types.String `tfsdk:"path"`
 	Name                        types.String `tfsdk:"name"`
@@ -59,6 +64,7 @@ type NotificationCustomScript struct {
 func (n NotificationCustomScript) toNotification() *Notification {
 	return &Notification{
 		Tags:                        n.Tags,
+		TagLabels:                   n.TagLabels,
 		Path:                        n.Path,
 		Arguments:                   n.Arguments,
 		Name:                        n.Name,
@@ -80,6 +86,7 @@ func (n NotificationCustomScript) toNotification() *Notification {
 
 func (n *NotificationCustomScript) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.Path = notification.Path
 	n.Arguments = notification.Arguments
 	n.Name = notification.Name
@@ -103,6 +110,7 @@ func (r *NotificationCustomScriptResource) Metadata(_ context.Context, req resou
 func (r *NotificationCustomScriptResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Custom Script resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect) and [Custom Script](https://wiki.servarr.com/whisparr/supported#customscript).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
@@ -163,6 +171,15 @@ func (r *NotificationCustomScriptResource) Schema(_ context.Context, _ resource.
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -184,6 +201,12 @@ func (r *NotificationCustomScriptResource) Schema(_ context.Context, _ resource.
 	}
 }
 
+func (r *NotificationCustomScriptResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationCustomScriptResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -200,12 +223,18 @@ func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationCustomScript
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationCustomScriptResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationCustomScriptResourceName, err)...)
 
 		return
 	}
@@ -213,6 +242,7 @@ func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resou
 	tflog.Trace(ctx, "created "+notificationCustomScriptResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -227,9 +257,11 @@ func (r *NotificationCustomScriptResource) Read(ctx context.Context, req resourc
 	}
 
 	// Get NotificationCustomScript current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationCustomScriptResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationCustomScriptResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationCustomScriptResourceName, err)...)
+		}
 
 		return
 	}
@@ -237,6 +269,7 @@ func (r *NotificationCustomScriptResource) Read(ctx context.Context, req resourc
 	tflog.Trace(ctx, "read "+notificationCustomScriptResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -250,12 +283,18 @@ func (r *NotificationCustomScriptResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationCustomScript
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationCustomScriptResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationCustomScriptResourceName, err)...)
 
 		return
 	}
@@ -263,6 +302,7 @@ func (r *NotificationCustomScriptResource) Update(ctx context.Context, req resou
 	tflog.Trace(ctx, "updated "+notificationCustomScriptResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -278,7 +318,7 @@ func (r *NotificationCustomScriptResource) Delete(ctx context.Context, req resou
 	// Delete NotificationCustomScript current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationCustomScriptResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationCustomScriptResourceName, err)...)
 
 		return
 	}
@@ -292,6 +332,12 @@ func (r *NotificationCustomScriptResource) ImportState(ctx context.Context, req
 	tflog.Trace(ctx, "imported "+notificationCustomScriptResourceName+": "+req.ID)
 }
 
+func (r *NotificationCustomScriptResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewNotificationResource(), "implementation", notificationCustomScriptImplementation),
+	}
+}
+
 func (n *NotificationCustomScript) write(ctx context.Context, notification *whisparr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
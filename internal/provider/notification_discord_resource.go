@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +26,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationDiscordResource{}
-	_ resource.ResourceWithImportState = &NotificationDiscordResource{}
+	_ resource.Resource                 = &NotificationDiscordResource{}
+	_ resource.ResourceWithImportState  = &NotificationDiscordResource{}
+	_ resource.ResourceWithMoveState    = &NotificationDiscordResource{}
+	_ resource.ResourceWithUpgradeState = &NotificationDiscordResource{}
 )
 
 func NewNotificationDiscordResource() resource.Resource {
@@ -34,12 +38,13 @@ func NewNotificationDiscordResource() resource.Resource {
 
 // NotificationDiscordResource defines the notification implementation.
 type NotificationDiscordResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // NotificationDiscord describes the notification data model.
 type NotificationDiscord struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	ImportFields                types.Set    `tfsdk:"import_fields"`
 	GrabFields                  types.Set    `tfsdk:"grab_fields"`
 	WebHookURL                  types.String `tfsdk:"web_hook_url"`
@@ -63,6 +68,7 @@ type NotificationDiscord struct {
 func (n NotificationDiscord) toNotification() *Notification {
 	return &Notification{
 		Tags:                        n.Tags,
+		TagLabels:                   n.TagLabels,
 		ImportFields:                n.ImportFields,
 		GrabFields:                  n.GrabFields,
 		WebHookURL:                  n.WebHookURL,
@@ -88,6 +94,7 @@ func (n NotificationDiscord) toNotification() *Notification {
 
 func (n *NotificationDiscord) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.GrabFields = notification.GrabFields
 	n.ImportFields = notification.ImportFields
 	n.WebHookURL = notification.WebHookURL
@@ -115,6 +122,7 @@ func (r *NotificationDiscordResource) Metadata(_ context.Context, req resource.M
 func (r *NotificationDiscordResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Discord resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect) and [Discord](https://wiki.servarr.com/whisparr/supported#discord).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
@@ -175,6 +183,15 @@ func (r *NotificationDiscordResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -218,6 +235,12 @@ func (r *NotificationDiscordResource) Schema(_ context.Context, _ resource.Schem
 	}
 }
 
+func (r *NotificationDiscordResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationDiscordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -234,12 +257,18 @@ func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationDiscord
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationDiscordResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationDiscordResourceName, err)...)
 
 		return
 	}
@@ -247,6 +276,7 @@ func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.C
 	tflog.Trace(ctx, "created "+notificationDiscordResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -261,9 +291,11 @@ func (r *NotificationDiscordResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get NotificationDiscord current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationDiscordResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationDiscordResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationDiscordResourceName, err)...)
+		}
 
 		return
 	}
@@ -271,6 +303,7 @@ func (r *NotificationDiscordResource) Read(ctx context.Context, req resource.Rea
 	tflog.Trace(ctx, "read "+notificationDiscordResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -284,12 +317,18 @@ func (r *NotificationDiscordResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationDiscord
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationDiscordResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationDiscordResourceName, err)...)
 
 		return
 	}
@@ -297,6 +336,7 @@ func (r *NotificationDiscordResource) Update(ctx context.Context, req resource.U
 	tflog.Trace(ctx, "updated "+notificationDiscordResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -312,7 +352,7 @@ func (r *NotificationDiscordResource) Delete(ctx context.Context, req resource.D
 	// Delete NotificationDiscord current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationDiscordResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationDiscordResourceName, err)...)
 
 		return
 	}
@@ -326,6 +366,12 @@ func (r *NotificationDiscordResource) ImportState(ctx context.Context, req resou
 	tflog.Trace(ctx, "imported "+notificationDiscordResourceName+": "+req.ID)
 }
 
+func (r *NotificationDiscordResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewNotificationResource(), "implementation", notificationDiscordImplementation),
+	}
+}
+
 func (n *NotificationDiscord) write(ctx context.Context, notification *whisparr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +26,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationEmailResource{}
-	_ resource.ResourceWithImportState = &NotificationEmailResource{}
+	_ resource.Resource                 = &NotificationEmailResource{}
+	_ resource.ResourceWithImportState  = &NotificationEmailResource{}
+	_ resource.ResourceWithMoveState    = &NotificationEmailResource{}
+	_ resource.ResourceWithUpgradeState = &NotificationEmailResource{}
 )
 
 func NewNotificationEmailResource() resource.Resource {
@@ -34,12 +38,13 @@ func NewNotificationEmailResource() resource.Resource {
 
 // NotificationEmailResource defines the notification implementation.
 type NotificationEmailResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // NotificationEmail describes the notification data model.
 type NotificationEmail struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	To                          types.Set    `tfsdk:"to"`
 	Cc                          types.Set    `tfsdk:"cc"`
 	Bcc                         types.Set    `tfsdk:"bcc"`
@@ -65,6 +70,7 @@ type NotificationEmail struct {
 func (n NotificationEmail) toNotification() *Notification {
 	return &Notification{
 		Tags:                        n.Tags,
+		TagLabels:                   n.TagLabels,
 		From:                        n.From,
 		To:                          n.To,
 		Cc:                          n.Cc,
@@ -92,6 +98,7 @@ func (n NotificationEmail) toNotification() *Notification {
 
 func (n *NotificationEmail) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.From = notification.From
 	n.To = notification.To
 	n.Cc = notification.Cc
@@ -121,6 +128,7 @@ func (r *NotificationEmailResource) Metadata(_ context.Context, req resource.Met
 func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Email resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect) and [Email](https://wiki.servarr.com/whisparr/supported#email).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
@@ -176,6 +184,15 @@ func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -234,6 +251,12 @@ func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
+func (r *NotificationEmailResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationEmailResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -250,12 +273,18 @@ func (r *NotificationEmailResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationEmail
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationEmailResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationEmailResourceName, err)...)
 
 		return
 	}
@@ -263,6 +292,7 @@ func (r *NotificationEmailResource) Create(ctx context.Context, req resource.Cre
 	tflog.Trace(ctx, "created "+notificationEmailResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -277,9 +307,11 @@ func (r *NotificationEmailResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get NotificationEmail current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationEmailResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationEmailResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationEmailResourceName, err)...)
+		}
 
 		return
 	}
@@ -287,6 +319,7 @@ func (r *NotificationEmailResource) Read(ctx context.Context, req resource.ReadR
 	tflog.Trace(ctx, "read "+notificationEmailResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -300,12 +333,18 @@ func (r *NotificationEmailResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationEmail
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationEmailResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationEmailResourceName, err)...)
 
 		return
 	}
@@ -313,6 +352,7 @@ func (r *NotificationEmailResource) Update(ctx context.Context, req resource.Upd
 	tflog.Trace(ctx, "updated "+notificationEmailResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -328,7 +368,7 @@ func (r *NotificationEmailResource) Delete(ctx context.Context, req resource.Del
 	// Delete NotificationEmail current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationEmailResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationEmailResourceName, err)...)
 
 		return
 	}
@@ -342,6 +382,12 @@ func (r *NotificationEmailResource) ImportState(ctx context.Context, req resourc
 	tflog.Trace(ctx, "imported "+notificationEmailResourceName+": "+req.ID)
 }
 
+func (r *NotificationEmailResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewNotificationResource(), "implementation", notificationEmailImplementation),
+	}
+}
+
 func (n *NotificationEmail) write(ctx context.Context, notification *whisparr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +26,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationEmbyResource{}
-	_ resource.ResourceWithImportState = &NotificationEmbyResource{}
+	_ resource.Resource                 = &NotificationEmbyResource{}
+	_ resource.ResourceWithImportState  = &NotificationEmbyResource{}
+	_ resource.ResourceWithMoveState    = &NotificationEmbyResource{}
+	_ resource.ResourceWithUpgradeState = &NotificationEmbyResource{}
 )
 
 func NewNotificationEmbyResource() resource.Resource {
@@ -34,12 +38,13 @@ func NewNotificationEmbyResource() resource.Resource {
 
 // NotificationEmbyResource defines the notification implementation.
 type NotificationEmbyResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // NotificationEmby describes the notification data model.
 type NotificationEmby struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	Host                        types.String `tfsdk:"host"`
 	APIKey                      types.String `tfsdk:"api_key"`
 	Name                        types.String `tfsdk:"name"`
@@ -63,6 +68,7 @@ type NotificationEmby struct {
 func (n NotificationEmby) toNotification() *Notification {
 	return &Notification{
 		Tags:                        n.Tags,
+		TagLabels:                   n.TagLabels,
 		Host:                        n.Host,
 		Name:                        n.Name,
 		APIKey:                      n.APIKey,
@@ -88,6 +94,7 @@ func (n NotificationEmby) toNotification() *Notification {
 
 func (n *NotificationEmby) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.Host = notification.Host
 	n.Name = notification.Name
 	n.APIKey = notification.APIKey
@@ -115,6 +122,7 @@ func (r *NotificationEmbyResource) Metadata(_ context.Context, req resource.Meta
 func (r *NotificationEmbyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Emby resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect) and [Emby](https://wiki.servarr.com/whisparr/supported#mediabrowser).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
@@ -175,6 +183,15 @@ func (r *NotificationEmbyResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -216,6 +233,12 @@ func (r *NotificationEmbyResource) Schema(_ context.Context, _ resource.SchemaRe
 	}
 }
 
+func (r *NotificationEmbyResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationEmbyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -232,12 +255,18 @@ func (r *NotificationEmbyResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationEmby
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationEmbyResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationEmbyResourceName, err)...)
 
 		return
 	}
@@ -245,6 +274,7 @@ func (r *NotificationEmbyResource) Create(ctx context.Context, req resource.Crea
 	tflog.Trace(ctx, "created "+notificationEmbyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -259,9 +289,11 @@ func (r *NotificationEmbyResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get NotificationEmby current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationEmbyResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationEmbyResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationEmbyResourceName, err)...)
+		}
 
 		return
 	}
@@ -269,6 +301,7 @@ func (r *NotificationEmbyResource) Read(ctx context.Context, req resource.ReadRe
 	tflog.Trace(ctx, "read "+notificationEmbyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -282,12 +315,18 @@ func (r *NotificationEmbyResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationEmby
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationEmbyResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationEmbyResourceName, err)...)
 
 		return
 	}
@@ -295,6 +334,7 @@ func (r *NotificationEmbyResource) Update(ctx context.Context, req resource.Upda
 	tflog.Trace(ctx, "updated "+notificationEmbyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -310,7 +350,7 @@ func (r *NotificationEmbyResource) Delete(ctx context.Context, req resource.Dele
 	// Delete NotificationEmby current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationEmbyResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationEmbyResourceName, err)...)
 
 		return
 	}
@@ -324,6 +364,12 @@ func (r *NotificationEmbyResource) ImportState(ctx context.Context, req resource
 	tflog.Trace(ctx, "imported "+notificationEmbyResourceName+": "+req.ID)
 }
 
+func (r *NotificationEmbyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewNotificationResource(), "implementation", notificationEmbyImplementation),
+	}
+}
+
 func (n *NotificationEmby) write(ctx context.Context, notification *whisparr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
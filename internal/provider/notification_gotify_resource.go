@@ -7,6 +7,7 @@ import (
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationGotifyResource{}
-	_ resource.ResourceWithImportState = &NotificationGotifyResource{}
+	_ resource.Resource                 = &NotificationGotifyResource{}
+	_ resource.ResourceWithImportState  = &NotificationGotifyResource{}
+	_ resource.ResourceWithMoveState    = &NotificationGotifyResource{}
+	_ resource.ResourceWithUpgradeState = &NotificationGotifyResource{}
 )
 
 func NewNotificationGotifyResource() resource.Resource {
@@ -36,12 +39,13 @@ func NewNotificationGotifyResource() resource.Resource {
 
 // NotificationGotifyResource defines the notification implementation.
 type NotificationGotifyResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // NotificationGotify describes the notification data model.
 type NotificationGotify struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	Server                      types.String `tfsdk:"server"`
 	Name                        types.String `tfsdk:"name"`
 	AppToken                    types.String `tfsdk:"app_token"`
@@ -61,6 +65,7 @@ type NotificationGotify struct {
 func (n NotificationGotify) toNotification() *Notification {
 	return &Notification{
 		Tags:                        n.Tags,
+		TagLabels:                   n.TagLabels,
 		Server:                      n.Server,
 		AppToken:                    n.AppToken,
 		Priority:                    n.Priority,
@@ -82,6 +87,7 @@ func (n NotificationGotify) toNotification() *Notification {
 
 func (n *NotificationGotify) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.Server = notification.Server
 	n.AppToken = notification.AppToken
 	n.Priority = notification.Priority
@@ -105,6 +111,7 @@ func (r *NotificationGotifyResource) Metadata(_ context.Context, req resource.Me
 func (r *NotificationGotifyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Gotify resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect) and [Gotify](https://wiki.servarr.com/whisparr/supported#gotify).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
@@ -160,6 +167,15 @@ func (r *NotificationGotifyResource) Schema(_ context.Context, _ resource.Schema
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -189,6 +205,12 @@ func (r *NotificationGotifyResource) Schema(_ context.Context, _ resource.Schema
 	}
 }
 
+func (r *NotificationGotifyResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationGotifyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -205,12 +227,18 @@ func (r *NotificationGotifyResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationGotify
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationGotifyResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationGotifyResourceName, err)...)
 
 		return
 	}
@@ -218,6 +246,7 @@ func (r *NotificationGotifyResource) Create(ctx context.Context, req resource.Cr
 	tflog.Trace(ctx, "created "+notificationGotifyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -232,9 +261,11 @@ func (r *NotificationGotifyResource) Read(ctx context.Context, req resource.Read
 	}
 
 	// Get NotificationGotify current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationGotifyResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationGotifyResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationGotifyResourceName, err)...)
+		}
 
 		return
 	}
@@ -242,6 +273,7 @@ func (r *NotificationGotifyResource) Read(ctx context.Context, req resource.Read
 	tflog.Trace(ctx, "read "+notificationGotifyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -255,12 +287,18 @@ func (r *NotificationGotifyResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationGotify
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationGotifyResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationGotifyResourceName, err)...)
 
 		return
 	}
@@ -268,6 +306,7 @@ func (r *NotificationGotifyResource) Update(ctx context.Context, req resource.Up
 	tflog.Trace(ctx, "updated "+notificationGotifyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -283,7 +322,7 @@ func (r *NotificationGotifyResource) Delete(ctx context.Context, req resource.De
 	// Delete NotificationGotify current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationGotifyResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationGotifyResourceName, err)...)
 
 		return
 	}
@@ -297,6 +336,12 @@ func (r *NotificationGotifyResource) ImportState(ctx context.Context, req resour
 	tflog.Trace(ctx, "imported "+notificationGotifyResourceName+": "+req.ID)
 }
 
+func (r *NotificationGotifyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewNotificationResource(), "implementation", notificationGotifyImplementation),
+	}
+}
+
 func (n *NotificationGotify) write(ctx context.Context, notification *whisparr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -7,6 +7,7 @@ import (
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationJoinResource{}
-	_ resource.ResourceWithImportState = &NotificationJoinResource{}
+	_ resource.Resource                 = &NotificationJoinResource{}
+	_ resource.ResourceWithImportState  = &NotificationJoinResource{}
+	_ resource.ResourceWithMoveState    = &NotificationJoinResource{}
+	_ resource.ResourceWithUpgradeState = &NotificationJoinResource{}
 )
 
 func NewNotificationJoinResource() resource.Resource {
@@ -36,12 +39,13 @@ func NewNotificationJoinResource() resource.Resource {
 
 // NotificationJoinResource defines the notification implementation.
 type NotificationJoinResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // NotificationJoin describes the notification data model.
 type NotificationJoin struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	DeviceNames                 types.String `tfsdk:"device_names"`
 	Name                        types.String `tfsdk:"name"`
 	APIKey                      types.String `tfsdk:"api_key"`
@@ -61,6 +65,7 @@ type NotificationJoin struct {
 func (n NotificationJoin) toNotification() *Notification {
 	return &Notification{
 		Tags:                        n.Tags,
+		TagLabels:                   n.TagLabels,
 		DeviceNames:                 n.DeviceNames,
 		APIKey:                      n.APIKey,
 		Priority:                    n.Priority,
@@ -82,6 +87,7 @@ func (n NotificationJoin) toNotification() *Notification {
 
 func (n *NotificationJoin) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.DeviceNames = notification.DeviceNames
 	n.APIKey = notification.APIKey
 	n.Priority = notification.Priority
@@ -105,6 +111,7 @@ func (r *NotificationJoinResource) Metadata(_ context.Context, req resource.Meta
 func (r *NotificationJoinResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Join resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect) and [Join](https://wiki.servarr.com/whisparr/supported#join).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
@@ -160,6 +167,15 @@ func (r *NotificationJoinResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -189,6 +205,12 @@ func (r *NotificationJoinResource) Schema(_ context.Context, _ resource.SchemaRe
 	}
 }
 
+func (r *NotificationJoinResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationJoinResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -205,12 +227,18 @@ func (r *NotificationJoinResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationJoin
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationJoinResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationJoinResourceName, err)...)
 
 		return
 	}
@@ -218,6 +246,7 @@ func (r *NotificationJoinResource) Create(ctx context.Context, req resource.Crea
 	tflog.Trace(ctx, "created "+notificationJoinResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -232,9 +261,11 @@ func (r *NotificationJoinResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get NotificationJoin current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationJoinResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationJoinResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationJoinResourceName, err)...)
+		}
 
 		return
 	}
@@ -242,6 +273,7 @@ func (r *NotificationJoinResource) Read(ctx context.Context, req resource.ReadRe
 	tflog.Trace(ctx, "read "+notificationJoinResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -255,12 +287,18 @@ func (r *NotificationJoinResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationJoin
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationJoinResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationJoinResourceName, err)...)
 
 		return
 	}
@@ -268,6 +306,7 @@ func (r *NotificationJoinResource) Update(ctx context.Context, req resource.Upda
 	tflog.Trace(ctx, "updated "+notificationJoinResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -283,7 +322,7 @@ func (r *NotificationJoinResource) Delete(ctx context.Context, req resource.Dele
 	// Delete NotificationJoin current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationJoinResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationJoinResourceName, err)...)
 
 		return
 	}
@@ -297,6 +336,12 @@ func (r *NotificationJoinResource) ImportState(ctx context.Context, req resource
 	tflog.Trace(ctx, "imported "+notificationJoinResourceName+": "+req.ID)
 }
 
+func (r *NotificationJoinResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewNotificationResource(), "implementation", notificationJoinImplementation),
+	}
+}
+
 func (n *NotificationJoin) write(ctx context.Context, notification *whisparr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +26,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationKodiResource{}
-	_ resource.ResourceWithImportState = &NotificationKodiResource{}
+	_ resource.Resource                 = &NotificationKodiResource{}
+	_ resource.ResourceWithImportState  = &NotificationKodiResource{}
+	_ resource.ResourceWithMoveState    = &NotificationKodiResource{}
+	_ resource.ResourceWithUpgradeState = &NotificationKodiResource{}
 )
 
 func NewNotificationKodiResource() resource.Resource {
@@ -34,12 +38,13 @@ func NewNotificationKodiResource() resource.Resource {
 
 // NotificationKodiResource defines the notification implementation.
 type NotificationKodiResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // NotificationKodi describes the notification data model.
 type NotificationKodi struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	Host                        types.String `tfsdk:"host"`
 	Name                        types.String `tfsdk:"name"`
 	Username                    types.String `tfsdk:"username"`
@@ -67,6 +72,7 @@ type NotificationKodi struct {
 func (n NotificationKodi) toNotification() *Notification {
 	return &Notification{
 		Tags:                        n.Tags,
+		TagLabels:                   n.TagLabels,
 		Port:                        n.Port,
 		Host:                        n.Host,
 		DisplayTime:                 n.DisplayTime,
@@ -96,6 +102,7 @@ func (n NotificationKodi) toNotification() *Notification {
 
 func (n *NotificationKodi) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.Port = notification.Port
 	n.DisplayTime = notification.DisplayTime
 	n.Host = notification.Host
@@ -127,6 +134,7 @@ func (r *NotificationKodiResource) Metadata(_ context.Context, req resource.Meta
 func (r *NotificationKodiResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Kodi resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect) and [Kodi](https://wiki.servarr.com/whisparr/supported#xbmc).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
@@ -187,6 +195,15 @@ func (r *NotificationKodiResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -248,6 +265,12 @@ func (r *NotificationKodiResource) Schema(_ context.Context, _ resource.SchemaRe
 	}
 }
 
+func (r *NotificationKodiResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationKodiResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -264,12 +287,18 @@ func (r *NotificationKodiResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationKodi
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationKodiResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationKodiResourceName, err)...)
 
 		return
 	}
@@ -277,6 +306,7 @@ func (r *NotificationKodiResource) Create(ctx context.Context, req resource.Crea
 	tflog.Trace(ctx, "created "+notificationKodiResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -291,9 +321,11 @@ func (r *NotificationKodiResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get NotificationKodi current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationKodiResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationKodiResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationKodiResourceName, err)...)
+		}
 
 		return
 	}
@@ -301,6 +333,7 @@ func (r *NotificationKodiResource) Read(ctx context.Context, req resource.ReadRe
 	tflog.Trace(ctx, "read "+notificationKodiResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -314,12 +347,18 @@ func (r *NotificationKodiResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationKodi
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationKodiResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationKodiResourceName, err)...)
 
 		return
 	}
@@ -327,6 +366,7 @@ func (r *NotificationKodiResource) Update(ctx context.Context, req resource.Upda
 	tflog.Trace(ctx, "updated "+notificationKodiResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -342,7 +382,7 @@ func (r *NotificationKodiResource) Delete(ctx context.Context, req resource.Dele
 	// Delete NotificationKodi current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationKodiResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationKodiResourceName, err)...)
 
 		return
 	}
@@ -356,6 +396,12 @@ func (r *NotificationKodiResource) ImportState(ctx context.Context, req resource
 	tflog.Trace(ctx, "imported "+notificationKodiResourceName+": "+req.ID)
 }
 
+func (r *NotificationKodiResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewNotificationResource(), "implementation", notificationKodiImplementation),
+	}
+}
+
 func (n *NotificationKodi) write(ctx context.Context, notification *whisparr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
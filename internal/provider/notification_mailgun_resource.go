@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +26,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationMailgunResource{}
-	_ resource.ResourceWithImportState = &NotificationMailgunResource{}
+	_ resource.Resource                 = &NotificationMailgunResource{}
+	_ resource.ResourceWithImportState  = &NotificationMailgunResource{}
+	_ resource.ResourceWithMoveState    = &NotificationMailgunResource{}
+	_ resource.ResourceWithUpgradeState = &NotificationMailgunResource{}
 )
 
 func NewNotificationMailgunResource() resource.Resource {
@@ -34,12 +38,13 @@ func NewNotificationMailgunResource() resource.Resource {
 
 // NotificationMailgunResource defines the notification implementation.
 type NotificationMailgunResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // NotificationMailgun describes the notification data model.
 type NotificationMailgun struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	Recipients                  types.Set    `tfsdk:"recipients"`
 	From                        types.String `tfsdk:"from"`
 	SenderDomain                types.String `tfsdk:"sender_domain"`
@@ -61,6 +66,7 @@ type NotificationMailgun struct {
 func (n NotificationMailgun) toNotification() *Notification {
 	return &Notification{
 		Tags:                        n.Tags,
+		TagLabels:                   n.TagLabels,
 		Recipients:                  n.Recipients,
 		SenderDomain:                n.SenderDomain,
 		APIKey:                      n.APIKey,
@@ -84,6 +90,7 @@ func (n NotificationMailgun) toNotification() *Notification {
 
 func (n *NotificationMailgun) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.Recipients = notification.Recipients
 	n.SenderDomain = notification.SenderDomain
 	n.APIKey = notification.APIKey
@@ -109,6 +116,7 @@ func (r *NotificationMailgunResource) Metadata(_ context.Context, req resource.M
 func (r *NotificationMailgunResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Mailgun resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect) and [Mailgun](https://wiki.servarr.com/whisparr/supported#mailgun).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
@@ -164,6 +172,15 @@ func (r *NotificationMailgunResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -201,6 +218,12 @@ func (r *NotificationMailgunResource) Schema(_ context.Context, _ resource.Schem
 	}
 }
 
+func (r *NotificationMailgunResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationMailgunResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -217,12 +240,18 @@ func (r *NotificationMailgunResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationMailgun
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationMailgunResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationMailgunResourceName, err)...)
 
 		return
 	}
@@ -230,6 +259,7 @@ func (r *NotificationMailgunResource) Create(ctx context.Context, req resource.C
 	tflog.Trace(ctx, "created "+notificationMailgunResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -244,9 +274,11 @@ func (r *NotificationMailgunResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get NotificationMailgun current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationMailgunResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationMailgunResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationMailgunResourceName, err)...)
+		}
 
 		return
 	}
@@ -254,6 +286,7 @@ func (r *NotificationMailgunResource) Read(ctx context.Context, req resource.Rea
 	tflog.Trace(ctx, "read "+notificationMailgunResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -267,12 +300,18 @@ func (r *NotificationMailgunResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationMailgun
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationMailgunResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationMailgunResourceName, err)...)
 
 		return
 	}
@@ -280,6 +319,7 @@ func (r *NotificationMailgunResource) Update(ctx context.Context, req resource.U
 	tflog.Trace(ctx, "updated "+notificationMailgunResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -295,7 +335,7 @@ func (r *NotificationMailgunResource) Delete(ctx context.Context, req resource.D
 	// Delete NotificationMailgun current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationMailgunResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationMailgunResourceName, err)...)
 
 		return
 	}
@@ -309,6 +349,12 @@ func (r *NotificationMailgunResource) ImportState(ctx context.Context, req resou
 	tflog.Trace(ctx, "imported "+notificationMailgunResourceName+": "+req.ID)
 }
 
+func (r *NotificationMailgunResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewNotificationResource(), "implementation", notificationMailgunImplementation),
+	}
+}
+
 func (n *NotificationMailgun) write(ctx context.Context, notification *whisparr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +26,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationNotifiarrResource{}
-	_ resource.ResourceWithImportState = &NotificationNotifiarrResource{}
+	_ resource.Resource                 = &NotificationNotifiarrResource{}
+	_ resource.ResourceWithImportState  = &NotificationNotifiarrResource{}
+	_ resource.ResourceWithMoveState    = &NotificationNotifiarrResource{}
+	_ resource.ResourceWithUpgradeState = &NotificationNotifiarrResource{}
 )
 
 func NewNotificationNotifiarrResource() resource.Resource {
@@ -34,12 +38,13 @@ func NewNotificationNotifiarrResource() resource.Resource {
 
 // NotificationNotifiarrResource defines the notification implementation.
 type NotificationNotifiarrResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // NotificationNotifiarr describes the notification data model.
 type NotificationNotifiarr struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	Name                        types.String `tfsdk:"name"`
 	APIKey                      types.String `tfsdk:"api_key"`
 	ID                          types.Int64  `tfsdk:"id"`
@@ -57,6 +62,7 @@ type NotificationNotifiarr struct {
 func (n NotificationNotifiarr) toNotification() *Notification {
 	return &Notification{
 		Tags:                        n.Tags,
+		TagLabels:                   n.TagLabels,
 		APIKey:                      n.APIKey,
 		Name:                        n.Name,
 		ID:                          n.ID,
@@ -76,6 +82,7 @@ func (n NotificationNotifiarr) toNotification() *Notification {
 
 func (n *NotificationNotifiarr) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.APIKey = notification.APIKey
 	n.Name = notification.Name
 	n.ID = notification.ID
@@ -97,6 +104,7 @@ func (r *NotificationNotifiarrResource) Metadata(_ context.Context, req resource
 func (r *NotificationNotifiarrResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Notifiarr resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect) and [Notifiarr](https://wiki.servarr.com/whisparr/supported#notifiarr).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
@@ -152,6 +160,15 @@ func (r *NotificationNotifiarrResource) Schema(_ context.Context, _ resource.Sch
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -169,6 +186,12 @@ func (r *NotificationNotifiarrResource) Schema(_ context.Context, _ resource.Sch
 	}
 }
 
+func (r *NotificationNotifiarrResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationNotifiarrResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -185,12 +208,18 @@ func (r *NotificationNotifiarrResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationNotifiarr
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationNotifiarrResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationNotifiarrResourceName, err)...)
 
 		return
 	}
@@ -198,6 +227,7 @@ func (r *NotificationNotifiarrResource) Create(ctx context.Context, req resource
 	tflog.Trace(ctx, "created "+notificationNotifiarrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -212,9 +242,11 @@ func (r *NotificationNotifiarrResource) Read(ctx context.Context, req resource.R
 	}
 
 	// Get NotificationNotifiarr current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationNotifiarrResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationNotifiarrResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationNotifiarrResourceName, err)...)
+		}
 
 		return
 	}
@@ -222,6 +254,7 @@ func (r *NotificationNotifiarrResource) Read(ctx context.Context, req resource.R
 	tflog.Trace(ctx, "read "+notificationNotifiarrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -235,12 +268,18 @@ func (r *NotificationNotifiarrResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationNotifiarr
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationNotifiarrResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationNotifiarrResourceName, err)...)
 
 		return
 	}
@@ -248,6 +287,7 @@ func (r *NotificationNotifiarrResource) Update(ctx context.Context, req resource
 	tflog.Trace(ctx, "updated "+notificationNotifiarrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -263,7 +303,7 @@ func (r *NotificationNotifiarrResource) Delete(ctx context.Context, req resource
 	// Delete NotificationNotifiarr current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationNotifiarrResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationNotifiarrResourceName, err)...)
 
 		return
 	}
@@ -277,6 +317,12 @@ func (r *NotificationNotifiarrResource) ImportState(ctx context.Context, req res
 	tflog.Trace(ctx, "imported "+notificationNotifiarrResourceName+": "+req.ID)
 }
 
+func (r *NotificationNotifiarrResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewNotificationResource(), "implementation", notificationNotifiarrImplementation),
+	}
+}
+
 func (n *NotificationNotifiarr) write(ctx context.Context, notification *whisparr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +26,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationPlexResource{}
-	_ resource.ResourceWithImportState = &NotificationPlexResource{}
+	_ resource.Resource                 = &NotificationPlexResource{}
+	_ resource.ResourceWithImportState  = &NotificationPlexResource{}
+	_ resource.ResourceWithMoveState    = &NotificationPlexResource{}
+	_ resource.ResourceWithUpgradeState = &NotificationPlexResource{}
 )
 
 func NewNotificationPlexResource() resource.Resource {
@@ -34,12 +38,13 @@ func NewNotificationPlexResource() resource.Resource {
 
 // NotificationPlexResource defines the notification implementation.
 type NotificationPlexResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // NotificationPlex describes the notification data model.
 type NotificationPlex struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	Host                        types.String `tfsdk:"host"`
 	AuthToken                   types.String `tfsdk:"auth_token"`
 	Name                        types.String `tfsdk:"name"`
@@ -59,6 +64,7 @@ type NotificationPlex struct {
 func (n NotificationPlex) toNotification() *Notification {
 	return &Notification{
 		Tags:                        n.Tags,
+		TagLabels:                   n.TagLabels,
 		Host:                        n.Host,
 		Name:                        n.Name,
 		AuthToken:                   n.AuthToken,
@@ -80,6 +86,7 @@ func (n NotificationPlex) toNotification() *Notification {
 
 func (n *NotificationPlex) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.Host = notification.Host
 	n.Name = notification.Name
 	n.AuthToken = notification.AuthToken
@@ -103,6 +110,7 @@ func (r *NotificationPlexResource) Metadata(_ context.Context, req resource.Meta
 func (r *NotificationPlexResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Plex resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect) and [Plex](https://wiki.servarr.com/whisparr/supported#plexserver).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_download": schema.BoolAttribute{
 				MarkdownDescription: "On download flag.",
@@ -148,6 +156,15 @@ func (r *NotificationPlexResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -184,6 +201,12 @@ func (r *NotificationPlexResource) Schema(_ context.Context, _ resource.SchemaRe
 	}
 }
 
+func (r *NotificationPlexResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationPlexResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -200,12 +223,18 @@ func (r *NotificationPlexResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationPlex
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationPlexResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationPlexResourceName, err)...)
 
 		return
 	}
@@ -213,6 +242,7 @@ func (r *NotificationPlexResource) Create(ctx context.Context, req resource.Crea
 	tflog.Trace(ctx, "created "+notificationPlexResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -227,9 +257,11 @@ func (r *NotificationPlexResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get NotificationPlex current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationPlexResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationPlexResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationPlexResourceName, err)...)
+		}
 
 		return
 	}
@@ -237,6 +269,7 @@ func (r *NotificationPlexResource) Read(ctx context.Context, req resource.ReadRe
 	tflog.Trace(ctx, "read "+notificationPlexResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -250,12 +283,18 @@ func (r *NotificationPlexResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationPlex
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationPlexResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationPlexResourceName, err)...)
 
 		return
 	}
@@ -263,6 +302,7 @@ func (r *NotificationPlexResource) Update(ctx context.Context, req resource.Upda
 	tflog.Trace(ctx, "updated "+notificationPlexResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -278,7 +318,7 @@ func (r *NotificationPlexResource) Delete(ctx context.Context, req resource.Dele
 	// Delete NotificationPlex current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationPlexResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationPlexResourceName, err)...)
 
 		return
 	}
@@ -292,6 +332,12 @@ func (r *NotificationPlexResource) ImportState(ctx context.Context, req resource
 	tflog.Trace(ctx, "imported "+notificationPlexResourceName+": "+req.ID)
 }
 
+func (r *NotificationPlexResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewNotificationResource(), "implementation", notificationPlexImplementation),
+	}
+}
+
 func (n *NotificationPlex) write(ctx context.Context, notification *whisparr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
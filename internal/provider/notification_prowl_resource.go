@@ -7,6 +7,7 @@ import (
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationProwlResource{}
-	_ resource.ResourceWithImportState = &NotificationProwlResource{}
+	_ resource.Resource                 = &NotificationProwlResource{}
+	_ resource.ResourceWithImportState  = &NotificationProwlResource{}
+	_ resource.ResourceWithMoveState    = &NotificationProwlResource{}
+	_ resource.ResourceWithUpgradeState = &NotificationProwlResource{}
 )
 
 func NewNotificationProwlResource() resource.Resource {
@@ -36,12 +39,13 @@ func NewNotificationProwlResource() resource.Resource {
 
 // NotificationProwlResource defines the notification implementation.
 type NotificationProwlResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // NotificationProwl describes the notification data model.
 type NotificationProwl struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	Name                        types.String `tfsdk:"name"`
 	APIKey                      types.String `tfsdk:"api_key"`
 	Priority                    types.Int64  `tfsdk:"priority"`
@@ -60,6 +64,7 @@ type NotificationProwl struct {
 func (n NotificationProwl) toNotification() *Notification {
 	return &Notification{
 		Tags:                        n.Tags,
+		TagLabels:                   n.TagLabels,
 		APIKey:                      n.APIKey,
 		Priority:                    n.Priority,
 		Name:                        n.Name,
@@ -80,6 +85,7 @@ func (n NotificationProwl) toNotification() *Notification {
 
 func (n *NotificationProwl) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.APIKey = notification.APIKey
 	n.Priority = notification.Priority
 	n.Name = notification.Name
@@ -102,6 +108,7 @@ func (r *NotificationProwlResource) Metadata(_ context.Context, req resource.Met
 func (r *NotificationProwlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Prowl resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect) and [Prowl](https://wiki.servarr.com/whisparr/supported#prowl).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
@@ -157,6 +164,15 @@ func (r *NotificationProwlResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -182,6 +198,12 @@ func (r *NotificationProwlResource) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
+func (r *NotificationProwlResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationProwlResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -198,12 +220,18 @@ func (r *NotificationProwlResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationProwl
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationProwlResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationProwlResourceName, err)...)
 
 		return
 	}
@@ -211,6 +239,7 @@ func (r *NotificationProwlResource) Create(ctx context.Context, req resource.Cre
 	tflog.Trace(ctx, "created "+notificationProwlResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -225,9 +254,11 @@ func (r *NotificationProwlResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get NotificationProwl current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationProwlResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationProwlResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationProwlResourceName, err)...)
+		}
 
 		return
 	}
@@ -235,6 +266,7 @@ func (r *NotificationProwlResource) Read(ctx context.Context, req resource.ReadR
 	tflog.Trace(ctx, "read "+notificationProwlResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -248,12 +280,18 @@ func (r *NotificationProwlResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationProwl
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationProwlResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationProwlResourceName, err)...)
 
 		return
 	}
@@ -261,6 +299,7 @@ func (r *NotificationProwlResource) Update(ctx context.Context, req resource.Upd
 	tflog.Trace(ctx, "updated "+notificationProwlResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -276,7 +315,7 @@ func (r *NotificationProwlResource) Delete(ctx context.Context, req resource.Del
 	// Delete NotificationProwl current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationProwlResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationProwlResourceName, err)...)
 
 		return
 	}
@@ -290,6 +329,12 @@ func (r *NotificationProwlResource) ImportState(ctx context.Context, req resourc
 	tflog.Trace(ctx, "imported "+notificationProwlResourceName+": "+req.ID)
 }
 
+func (r *NotificationProwlResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewNotificationResource(), "implementation", notificationProwlImplementation),
+	}
+}
+
 func (n *NotificationProwl) write(ctx context.Context, notification *whisparr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
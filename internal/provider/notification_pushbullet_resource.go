@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +26,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationPushbulletResource{}
-	_ resource.ResourceWithImportState = &NotificationPushbulletResource{}
+	_ resource.Resource                 = &NotificationPushbulletResource{}
+	_ resource.ResourceWithImportState  = &NotificationPushbulletResource{}
+	_ resource.ResourceWithMoveState    = &NotificationPushbulletResource{}
+	_ resource.ResourceWithUpgradeState = &NotificationPushbulletResource{}
 )
 
 func NewNotificationPushbulletResource() resource.Resource {
@@ -34,12 +38,13 @@ func NewNotificationPushbulletResource() resource.Resource {
 
 // NotificationPushbulletResource defines the notification implementation.
 type NotificationPushbulletResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // NotificationPushbullet describes the notification data model.
 type NotificationPushbullet struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	DeviceIds                   types.Set    `tfsdk:"device_ids"`
 	ChannelTags                 types.Set    `tfsdk:"channel_tags"`
 	SenderID                    types.String `tfsdk:"sender_id"`
@@ -60,6 +65,7 @@ type NotificationPushbullet struct {
 func (n NotificationPushbullet) toNotification() *Notification {
 	return &Notification{
 		Tags:                        n.Tags,
+		TagLabels:                   n.TagLabels,
 		DeviceIds:                   n.DeviceIds,
 		ChannelTags:                 n.ChannelTags,
 		SenderID:                    n.SenderID,
@@ -82,6 +88,7 @@ func (n NotificationPushbullet) toNotification() *Notification {
 
 func (n *NotificationPushbullet) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.DeviceIds = notification.DeviceIds
 	n.ChannelTags = notification.ChannelTags
 	n.SenderID = notification.SenderID
@@ -106,6 +113,7 @@ func (r *NotificationPushbulletResource) Metadata(_ context.Context, req resourc
 func (r *NotificationPushbulletResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Pushbullet resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect) and [Pushbullet](https://wiki.servarr.com/whisparr/supported#pushbullet).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
@@ -161,6 +169,15 @@ func (r *NotificationPushbulletResource) Schema(_ context.Context, _ resource.Sc
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -195,6 +212,12 @@ func (r *NotificationPushbulletResource) Schema(_ context.Context, _ resource.Sc
 	}
 }
 
+func (r *NotificationPushbulletResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationPushbulletResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -211,12 +234,18 @@ func (r *NotificationPushbulletResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationPushbullet
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationPushbulletResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationPushbulletResourceName, err)...)
 
 		return
 	}
@@ -224,6 +253,7 @@ func (r *NotificationPushbulletResource) Create(ctx context.Context, req resourc
 	tflog.Trace(ctx, "created "+notificationPushbulletResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -238,9 +268,11 @@ func (r *NotificationPushbulletResource) Read(ctx context.Context, req resource.
 	}
 
 	// Get NotificationPushbullet current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationPushbulletResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationPushbulletResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationPushbulletResourceName, err)...)
+		}
 
 		return
 	}
@@ -248,6 +280,7 @@ func (r *NotificationPushbulletResource) Read(ctx context.Context, req resource.
 	tflog.Trace(ctx, "read "+notificationPushbulletResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -261,12 +294,18 @@ func (r *NotificationPushbulletResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationPushbullet
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationPushbulletResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationPushbulletResourceName, err)...)
 
 		return
 	}
@@ -274,6 +313,7 @@ func (r *NotificationPushbulletResource) Update(ctx context.Context, req resourc
 	tflog.Trace(ctx, "updated "+notificationPushbulletResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -289,7 +329,7 @@ func (r *NotificationPushbulletResource) Delete(ctx context.Context, req resourc
 	// Delete NotificationPushbullet current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationPushbulletResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationPushbulletResourceName, err)...)
 
 		return
 	}
@@ -303,6 +343,12 @@ func (r *NotificationPushbulletResource) ImportState(ctx context.Context, req re
 	tflog.Trace(ctx, "imported "+notificationPushbulletResourceName+": "+req.ID)
 }
 
+func (r *NotificationPushbulletResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewNotificationResource(), "implementation", notificationPushbulletImplementation),
+	}
+}
+
 func (n *NotificationPushbullet) write(ctx context.Context, notification *whisparr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -7,6 +7,7 @@ import (
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,8 +27,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationPushoverResource{}
-	_ resource.ResourceWithImportState = &NotificationPushoverResource{}
+	_ resource.Resource                 = &NotificationPushoverResource{}
+	_ resource.ResourceWithImportState  = &NotificationPushoverResource{}
+	_ resource.ResourceWithMoveState    = &NotificationPushoverResource{}
+	_ resource.ResourceWithUpgradeState = &NotificationPushoverResource{}
 )
 
 func NewNotificationPushoverResource() resource.Resource {
@@ -36,12 +39,13 @@ func NewNotificationPushoverResource() resource.Resource {
 
 // NotificationPushoverResource defines the notification implementation.
 type NotificationPushoverResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // NotificationPushover describes the notification data model.
 type NotificationPushover struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	Devices                     types.Set    `tfsdk:"devices"`
 	Sound                       types.String `tfsdk:"sound"`
 	Name                        types.String `tfsdk:"name"`
@@ -65,6 +69,7 @@ type NotificationPushover struct {
 func (n NotificationPushover) toNotification() *Notification {
 	return &Notification{
 		Tags:                        n.Tags,
+		TagLabels:                   n.TagLabels,
 		Devices:                     n.Devices,
 		Sound:                       n.Sound,
 		APIKey:                      n.APIKey,
@@ -90,6 +95,7 @@ func (n NotificationPushover) toNotification() *Notification {
 
 func (n *NotificationPushover) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.Devices = notification.Devices
 	n.Sound = notification.Sound
 	n.APIKey = notification.APIKey
@@ -117,6 +123,7 @@ func (r *NotificationPushoverResource) Metadata(_ context.Context, req resource.
 func (r *NotificationPushoverResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Pushover resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect) and [Pushover](https://wiki.servarr.com/whisparr/supported#pushover).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
@@ -172,6 +179,15 @@ func (r *NotificationPushoverResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -223,6 +239,12 @@ func (r *NotificationPushoverResource) Schema(_ context.Context, _ resource.Sche
 	}
 }
 
+func (r *NotificationPushoverResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationPushoverResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -239,12 +261,18 @@ func (r *NotificationPushoverResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationPushover
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationPushoverResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationPushoverResourceName, err)...)
 
 		return
 	}
@@ -252,6 +280,7 @@ func (r *NotificationPushoverResource) Create(ctx context.Context, req resource.
 	tflog.Trace(ctx, "created "+notificationPushoverResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -266,9 +295,11 @@ func (r *NotificationPushoverResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get NotificationPushover current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationPushoverResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationPushoverResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationPushoverResourceName, err)...)
+		}
 
 		return
 	}
@@ -276,6 +307,7 @@ func (r *NotificationPushoverResource) Read(ctx context.Context, req resource.Re
 	tflog.Trace(ctx, "read "+notificationPushoverResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -289,12 +321,18 @@ func (r *NotificationPushoverResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationPushover
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationPushoverResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationPushoverResourceName, err)...)
 
 		return
 	}
@@ -302,6 +340,7 @@ func (r *NotificationPushoverResource) Update(ctx context.Context, req resource.
 	tflog.Trace(ctx, "updated "+notificationPushoverResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -317,7 +356,7 @@ func (r *NotificationPushoverResource) Delete(ctx context.Context, req resource.
 	// Delete NotificationPushover current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationPushoverResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationPushoverResourceName, err)...)
 
 		return
 	}
@@ -331,6 +370,12 @@ func (r *NotificationPushoverResource) ImportState(ctx context.Context, req reso
 	tflog.Trace(ctx, "imported "+notificationPushoverResourceName+": "+req.ID)
 }
 
+func (r *NotificationPushoverResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewNotificationResource(), "implementation", notificationPushoverImplementation),
+	}
+}
+
 func (n *NotificationPushover) write(ctx context.Context, notification *whisparr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
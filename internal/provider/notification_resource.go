@@ -7,6 +7,7 @@ import (
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -23,8 +24,10 @@ const notificationResourceName = "notification"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationResource{}
-	_ resource.ResourceWithImportState = &NotificationResource{}
+	_ resource.Resource                     = &NotificationResource{}
+	_ resource.ResourceWithImportState      = &NotificationResource{}
+	_ resource.ResourceWithConfigValidators = &NotificationResource{}
+	_ resource.ResourceWithUpgradeState     = &NotificationResource{}
 )
 
 var notificationFields = helpers.Fields{
@@ -42,12 +45,13 @@ func NewNotificationResource() resource.Resource {
 
 // NotificationResource defines the notification implementation.
 type NotificationResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // Notification describes the notification data model.
 type Notification struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	FieldTags                   types.Set    `tfsdk:"field_tags"`
 	ChannelTags                 types.Set    `tfsdk:"channel_tags"`
 	Topics                      types.Set    `tfsdk:"topics"`
@@ -134,6 +138,7 @@ func (n Notification) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
 			"tags":                             types.SetType{}.WithElementType(types.Int64Type),
+			"tag_labels":                       types.SetType{}.WithElementType(types.StringType),
 			"import_fields":                    types.SetType{}.WithElementType(types.Int64Type),
 			"grab_fields":                      types.SetType{}.WithElementType(types.Int64Type),
 			"field_tags":                       types.SetType{}.WithElementType(types.StringType),
@@ -223,7 +228,8 @@ func (r *NotificationResource) Metadata(_ context.Context, req resource.Metadata
 
 func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "<!-- subcategory:Notifications -->Notification resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect).",
+		MarkdownDescription: "<!-- subcategory:Notifications -->Generic Notification resource. When possible use a specific resource instead, existing ones can be moved there with a `moved` block (Terraform 1.8+).\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
@@ -292,6 +298,15 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -652,12 +667,52 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func (r *NotificationResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 	}
 }
 
+func (r *NotificationResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		helpers.ImplementationValidator{
+			Resources: map[string]func() resource.Resource{
+				notificationBoxcarImplementation:       NewNotificationBoxcarResource,
+				notificationCustomScriptImplementation: NewNotificationCustomScriptResource,
+				notificationDiscordImplementation:      NewNotificationDiscordResource,
+				notificationEmailImplementation:        NewNotificationEmailResource,
+				notificationEmbyImplementation:         NewNotificationEmbyResource,
+				notificationGotifyImplementation:       NewNotificationGotifyResource,
+				notificationJoinImplementation:         NewNotificationJoinResource,
+				notificationKodiImplementation:         NewNotificationKodiResource,
+				notificationMailgunImplementation:      NewNotificationMailgunResource,
+				notificationNotifiarrImplementation:    NewNotificationNotifiarrResource,
+				notificationPlexImplementation:         NewNotificationPlexResource,
+				notificationProwlImplementation:        NewNotificationProwlResource,
+				notificationPushbulletImplementation:   NewNotificationPushbulletResource,
+				notificationPushoverImplementation:     NewNotificationPushoverResource,
+				notificationSendgridImplementation:     NewNotificationSendgridResource,
+				notificationSimplepushImplementation:   NewNotificationSimplepushResource,
+				notificationSlackImplementation:        NewNotificationSlackResource,
+				notificationSynologyImplementation:     NewNotificationSynologyResource,
+				notificationTelegramImplementation:     NewNotificationTelegramResource,
+				notificationTraktImplementation:        NewNotificationTraktResource,
+				notificationTwitterImplementation:      NewNotificationTwitterResource,
+				notificationWebhookImplementation:      NewNotificationWebhookResource,
+			},
+			Attribute: "implementation",
+			Model:     &Notification{},
+			Fields:    notificationFields,
+		},
+	}
+}
+
 func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *Notification
@@ -668,12 +723,18 @@ func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new Notification
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationResourceName, err)...)
 
 		return
 	}
@@ -684,6 +745,7 @@ func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRe
 	var state Notification
 
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = r.client.TagLabels(ctx, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -698,9 +760,11 @@ func (r *NotificationResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get Notification current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationResourceName, err)...)
+		}
 
 		return
 	}
@@ -711,6 +775,7 @@ func (r *NotificationResource) Read(ctx context.Context, req resource.ReadReques
 	var state Notification
 
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = r.client.TagLabels(ctx, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -724,12 +789,18 @@ func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update Notification
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationResourceName, err)...)
 
 		return
 	}
@@ -740,6 +811,7 @@ func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRe
 	var state Notification
 
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = r.client.TagLabels(ctx, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -755,7 +827,7 @@ func (r *NotificationResource) Delete(ctx context.Context, req resource.DeleteRe
 	// Delete Notification current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationResourceName, err)...)
 
 		return
 	}
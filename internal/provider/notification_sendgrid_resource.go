@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +26,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationSendgridResource{}
-	_ resource.ResourceWithImportState = &NotificationSendgridResource{}
+	_ resource.Resource                 = &NotificationSendgridResource{}
+	_ resource.ResourceWithImportState  = &NotificationSendgridResource{}
+	_ resource.ResourceWithMoveState    = &NotificationSendgridResource{}
+	_ resource.ResourceWithUpgradeState = &NotificationSendgridResource{}
 )
 
 func NewNotificationSendgridResource() resource.Resource {
@@ -34,12 +38,13 @@ func NewNotificationSendgridResource() resource.Resource {
 
 // NotificationSendgridResource defines the notification implementation.
 type NotificationSendgridResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // NotificationSendgrid describes the notification data model.
 type NotificationSendgrid struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	Recipients                  types.Set    `tfsdk:"recipients"`
 	From                        types.String `tfsdk:"from"`
 	Name                        types.String `tfsdk:"name"`
@@ -59,6 +64,7 @@ type NotificationSendgrid struct {
 func (n NotificationSendgrid) toNotification() *Notification {
 	return &Notification{
 		Tags:                        n.Tags,
+		TagLabels:                   n.TagLabels,
 		Recipients:                  n.Recipients,
 		APIKey:                      n.APIKey,
 		Name:                        n.Name,
@@ -80,6 +86,7 @@ func (n NotificationSendgrid) toNotification() *Notification {
 
 func (n *NotificationSendgrid) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.Recipients = notification.Recipients
 	n.APIKey = notification.APIKey
 	n.Name = notification.Name
@@ -103,6 +110,7 @@ func (r *NotificationSendgridResource) Metadata(_ context.Context, req resource.
 func (r *NotificationSendgridResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Sendgrid resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect) and [Sendgrid](https://wiki.servarr.com/whisparr/supported#sendgrid).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
@@ -158,6 +166,15 @@ func (r *NotificationSendgridResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -185,6 +202,12 @@ func (r *NotificationSendgridResource) Schema(_ context.Context, _ resource.Sche
 	}
 }
 
+func (r *NotificationSendgridResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationSendgridResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -201,12 +224,18 @@ func (r *NotificationSendgridResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationSendgrid
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationSendgridResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationSendgridResourceName, err)...)
 
 		return
 	}
@@ -214,6 +243,7 @@ func (r *NotificationSendgridResource) Create(ctx context.Context, req resource.
 	tflog.Trace(ctx, "created "+notificationSendgridResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -228,9 +258,11 @@ func (r *NotificationSendgridResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get NotificationSendgrid current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationSendgridResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationSendgridResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationSendgridResourceName, err)...)
+		}
 
 		return
 	}
@@ -238,6 +270,7 @@ func (r *NotificationSendgridResource) Read(ctx context.Context, req resource.Re
 	tflog.Trace(ctx, "read "+notificationSendgridResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -251,12 +284,18 @@ func (r *NotificationSendgridResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationSendgrid
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationSendgridResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationSendgridResourceName, err)...)
 
 		return
 	}
@@ -264,6 +303,7 @@ func (r *NotificationSendgridResource) Update(ctx context.Context, req resource.
 	tflog.Trace(ctx, "updated "+notificationSendgridResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -279,7 +319,7 @@ func (r *NotificationSendgridResource) Delete(ctx context.Context, req resource.
 	// Delete NotificationSendgrid current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationSendgridResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationSendgridResourceName, err)...)
 
 		return
 	}
@@ -293,6 +333,12 @@ func (r *NotificationSendgridResource) ImportState(ctx context.Context, req reso
 	tflog.Trace(ctx, "imported "+notificationSendgridResourceName+": "+req.ID)
 }
 
+func (r *NotificationSendgridResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewNotificationResource(), "implementation", notificationSendgridImplementation),
+	}
+}
+
 func (n *NotificationSendgrid) write(ctx context.Context, notification *whisparr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +26,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationSynologyResource{}
-	_ resource.ResourceWithImportState = &NotificationSynologyResource{}
+	_ resource.Resource                 = &NotificationSynologyResource{}
+	_ resource.ResourceWithImportState  = &NotificationSynologyResource{}
+	_ resource.ResourceWithMoveState    = &NotificationSynologyResource{}
+	_ resource.ResourceWithUpgradeState = &NotificationSynologyResource{}
 )
 
 func NewNotificationSynologyResource() resource.Resource {
@@ -34,12 +38,13 @@ func NewNotificationSynologyResource() resource.Resource {
 
 // NotificationSynologyResource defines the notification implementation.
 type NotificationSynologyResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // NotificationSynology describes the notification data model.
 type NotificationSynology struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	Name                        types.String `tfsdk:"name"`
 	ID                          types.Int64  `tfsdk:"id"`
 	UpdateLibrary               types.Bool   `tfsdk:"update_library"`
@@ -55,6 +60,7 @@ type NotificationSynology struct {
 func (n NotificationSynology) toNotification() *Notification {
 	return &Notification{
 		Tags:                        n.Tags,
+		TagLabels:                   n.TagLabels,
 		Name:                        n.Name,
 		ID:                          n.ID,
 		UpdateLibrary:               n.UpdateLibrary,
@@ -72,6 +78,7 @@ func (n NotificationSynology) toNotification() *Notification {
 
 func (n *NotificationSynology) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.Name = notification.Name
 	n.ID = notification.ID
 	n.UpdateLibrary = notification.UpdateLibrary
@@ -91,6 +98,7 @@ func (r *NotificationSynologyResource) Metadata(_ context.Context, req resource.
 func (r *NotificationSynologyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Synology Indexer resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect) and [Synology](https://wiki.servarr.com/whisparr/supported#synologyindexer).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_download": schema.BoolAttribute{
 				MarkdownDescription: "On download flag.",
@@ -136,6 +144,15 @@ func (r *NotificationSynologyResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -153,6 +170,12 @@ func (r *NotificationSynologyResource) Schema(_ context.Context, _ resource.Sche
 	}
 }
 
+func (r *NotificationSynologyResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationSynologyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -169,12 +192,18 @@ func (r *NotificationSynologyResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationSynology
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationSynologyResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationSynologyResourceName, err)...)
 
 		return
 	}
@@ -182,6 +211,7 @@ func (r *NotificationSynologyResource) Create(ctx context.Context, req resource.
 	tflog.Trace(ctx, "created "+notificationSynologyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -196,9 +226,11 @@ func (r *NotificationSynologyResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get NotificationSynology current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationSynologyResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationSynologyResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationSynologyResourceName, err)...)
+		}
 
 		return
 	}
@@ -206,6 +238,7 @@ func (r *NotificationSynologyResource) Read(ctx context.Context, req resource.Re
 	tflog.Trace(ctx, "read "+notificationSynologyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -219,12 +252,18 @@ func (r *NotificationSynologyResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationSynology
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationSynologyResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationSynologyResourceName, err)...)
 
 		return
 	}
@@ -232,6 +271,7 @@ func (r *NotificationSynologyResource) Update(ctx context.Context, req resource.
 	tflog.Trace(ctx, "updated "+notificationSynologyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -247,7 +287,7 @@ func (r *NotificationSynologyResource) Delete(ctx context.Context, req resource.
 	// Delete NotificationSynology current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationSynologyResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationSynologyResourceName, err)...)
 
 		return
 	}
@@ -261,6 +301,12 @@ func (r *NotificationSynologyResource) ImportState(ctx context.Context, req reso
 	tflog.Trace(ctx, "imported "+notificationSynologyResourceName+": "+req.ID)
 }
 
+func (r *NotificationSynologyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewNotificationResource(), "implementation", notificationSynologyImplementation),
+	}
+}
+
 func (n *NotificationSynology) write(ctx context.Context, notification *whisparr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
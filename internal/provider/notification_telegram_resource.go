@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +26,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationTelegramResource{}
-	_ resource.ResourceWithImportState = &NotificationTelegramResource{}
+	_ resource.Resource                 = &NotificationTelegramResource{}
+	_ resource.ResourceWithImportState  = &NotificationTelegramResource{}
+	_ resource.ResourceWithMoveState    = &NotificationTelegramResource{}
+	_ resource.ResourceWithUpgradeState = &NotificationTelegramResource{}
 )
 
 func NewNotificationTelegramResource() resource.Resource {
@@ -34,12 +38,13 @@ func NewNotificationTelegramResource() resource.Resource {
 
 // NotificationTelegramResource defines the notification implementation.
 type NotificationTelegramResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // NotificationTelegram describes the notification data model.
 type NotificationTelegram struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	ChatID                      types.String `tfsdk:"chat_id"`
 	Name                        types.String `tfsdk:"name"`
 	BotToken                    types.String `tfsdk:"bot_token"`
@@ -59,6 +64,7 @@ type NotificationTelegram struct {
 func (n NotificationTelegram) toNotification() *Notification {
 	return &Notification{
 		Tags:                        n.Tags,
+		TagLabels:                   n.TagLabels,
 		ChatID:                      n.ChatID,
 		BotToken:                    n.BotToken,
 		SendSilently:                n.SendSilently,
@@ -80,6 +86,7 @@ func (n NotificationTelegram) toNotification() *Notification {
 
 func (n *NotificationTelegram) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.ChatID = notification.ChatID
 	n.BotToken = notification.BotToken
 	n.SendSilently = notification.SendSilently
@@ -103,6 +110,7 @@ func (r *NotificationTelegramResource) Metadata(_ context.Context, req resource.
 func (r *NotificationTelegramResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Telegram resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect) and [Telegram](https://wiki.servarr.com/whisparr/supported#telegram).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
@@ -158,6 +166,15 @@ func (r *NotificationTelegramResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -184,6 +201,12 @@ func (r *NotificationTelegramResource) Schema(_ context.Context, _ resource.Sche
 	}
 }
 
+func (r *NotificationTelegramResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationTelegramResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -200,12 +223,18 @@ func (r *NotificationTelegramResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationTelegram
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationTelegramResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationTelegramResourceName, err)...)
 
 		return
 	}
@@ -213,6 +242,7 @@ func (r *NotificationTelegramResource) Create(ctx context.Context, req resource.
 	tflog.Trace(ctx, "created "+notificationTelegramResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -227,9 +257,11 @@ func (r *NotificationTelegramResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get NotificationTelegram current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationTelegramResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationTelegramResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationTelegramResourceName, err)...)
+		}
 
 		return
 	}
@@ -237,6 +269,7 @@ func (r *NotificationTelegramResource) Read(ctx context.Context, req resource.Re
 	tflog.Trace(ctx, "read "+notificationTelegramResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -250,12 +283,18 @@ func (r *NotificationTelegramResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationTelegram
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationTelegramResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationTelegramResourceName, err)...)
 
 		return
 	}
@@ -263,6 +302,7 @@ func (r *NotificationTelegramResource) Update(ctx context.Context, req resource.
 	tflog.Trace(ctx, "updated "+notificationTelegramResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -278,7 +318,7 @@ func (r *NotificationTelegramResource) Delete(ctx context.Context, req resource.
 	// Delete NotificationTelegram current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationTelegramResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationTelegramResourceName, err)...)
 
 		return
 	}
@@ -292,6 +332,12 @@ func (r *NotificationTelegramResource) ImportState(ctx context.Context, req reso
 	tflog.Trace(ctx, "imported "+notificationTelegramResourceName+": "+req.ID)
 }
 
+func (r *NotificationTelegramResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewNotificationResource(), "implementation", notificationTelegramImplementation),
+	}
+}
+
 func (n *NotificationTelegram) write(ctx context.Context, notification *whisparr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
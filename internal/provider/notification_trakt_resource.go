@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +26,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationTraktResource{}
-	_ resource.ResourceWithImportState = &NotificationTraktResource{}
+	_ resource.Resource                 = &NotificationTraktResource{}
+	_ resource.ResourceWithImportState  = &NotificationTraktResource{}
+	_ resource.ResourceWithMoveState    = &NotificationTraktResource{}
+	_ resource.ResourceWithUpgradeState = &NotificationTraktResource{}
 )
 
 func NewNotificationTraktResource() resource.Resource {
@@ -34,12 +38,13 @@ func NewNotificationTraktResource() resource.Resource {
 
 // NotificationTraktResource defines the notification implementation.
 type NotificationTraktResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // NotificationTrakt describes the notification data model.
 type NotificationTrakt struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	AuthUser                    types.String `tfsdk:"auth_user"`
 	AccessToken                 types.String `tfsdk:"access_token"`
 	RefreshToken                types.String `tfsdk:"refresh_token"`
@@ -57,6 +62,7 @@ type NotificationTrakt struct {
 func (n NotificationTrakt) toNotification() *Notification {
 	return &Notification{
 		Tags:                        n.Tags,
+		TagLabels:                   n.TagLabels,
 		AuthUser:                    n.AuthUser,
 		Name:                        n.Name,
 		AccessToken:                 n.AccessToken,
@@ -76,6 +82,7 @@ func (n NotificationTrakt) toNotification() *Notification {
 
 func (n *NotificationTrakt) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.AuthUser = notification.AuthUser
 	n.Name = notification.Name
 	n.AccessToken = notification.AccessToken
@@ -97,6 +104,7 @@ func (r *NotificationTraktResource) Metadata(_ context.Context, req resource.Met
 func (r *NotificationTraktResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Trakt resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect) and [Trakt](https://wiki.servarr.com/whisparr/supported#trakt).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_download": schema.BoolAttribute{
 				MarkdownDescription: "On download flag.",
@@ -137,6 +145,15 @@ func (r *NotificationTraktResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -168,6 +185,12 @@ func (r *NotificationTraktResource) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
+func (r *NotificationTraktResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationTraktResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -184,12 +207,18 @@ func (r *NotificationTraktResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationTrakt
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationTraktResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationTraktResourceName, err)...)
 
 		return
 	}
@@ -197,6 +226,7 @@ func (r *NotificationTraktResource) Create(ctx context.Context, req resource.Cre
 	tflog.Trace(ctx, "created "+notificationTraktResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -211,9 +241,11 @@ func (r *NotificationTraktResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get NotificationTrakt current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationTraktResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationTraktResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationTraktResourceName, err)...)
+		}
 
 		return
 	}
@@ -221,6 +253,7 @@ func (r *NotificationTraktResource) Read(ctx context.Context, req resource.ReadR
 	tflog.Trace(ctx, "read "+notificationTraktResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -234,12 +267,18 @@ func (r *NotificationTraktResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationTrakt
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationTraktResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationTraktResourceName, err)...)
 
 		return
 	}
@@ -247,6 +286,7 @@ func (r *NotificationTraktResource) Update(ctx context.Context, req resource.Upd
 	tflog.Trace(ctx, "updated "+notificationTraktResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -262,7 +302,7 @@ func (r *NotificationTraktResource) Delete(ctx context.Context, req resource.Del
 	// Delete NotificationTrakt current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationTraktResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationTraktResourceName, err)...)
 
 		return
 	}
@@ -276,6 +316,12 @@ func (r *NotificationTraktResource) ImportState(ctx context.Context, req resourc
 	tflog.Trace(ctx, "imported "+notificationTraktResourceName+": "+req.ID)
 }
 
+func (r *NotificationTraktResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewNotificationResource(), "implementation", notificationTraktImplementation),
+	}
+}
+
 func (n *NotificationTrakt) write(ctx context.Context, notification *whisparr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
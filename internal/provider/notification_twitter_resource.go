@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +26,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationTwitterResource{}
-	_ resource.ResourceWithImportState = &NotificationTwitterResource{}
+	_ resource.Resource                 = &NotificationTwitterResource{}
+	_ resource.ResourceWithImportState  = &NotificationTwitterResource{}
+	_ resource.ResourceWithMoveState    = &NotificationTwitterResource{}
+	_ resource.ResourceWithUpgradeState = &NotificationTwitterResource{}
 )
 
 func NewNotificationTwitterResource() resource.Resource {
@@ -34,12 +38,13 @@ func NewNotificationTwitterResource() resource.Resource {
 
 // NotificationTwitterResource defines the notification implementation.
 type NotificationTwitterResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // NotificationTwitter describes the notification data model.
 type NotificationTwitter struct {
 	Tags                        types.Set    `tfsdk:"tags"`
+	TagLabels                   types.Set    `tfsdk:"tag_labels"`
 	Name                        types.String `tfsdk:"name"`
 	AccessToken                 types.String `tfsdk:"access_token"`
 	AccessTokenSecret           types.String `tfsdk:"access_token_secret"`
@@ -62,6 +67,7 @@ type NotificationTwitter struct {
 func (n NotificationTwitter) toNotification() *Notification {
 	return &Notification{
 		Tags:                        n.Tags,
+		TagLabels:                   n.TagLabels,
 		AccessToken:                 n.AccessToken,
 		AccessTokenSecret:           n.AccessTokenSecret,
 		ConsumerKey:                 n.ConsumerKey,
@@ -86,6 +92,7 @@ func (n NotificationTwitter) toNotification() *Notification {
 
 func (n *NotificationTwitter) fromNotification(notification *Notification) {
 	n.Tags = notification.Tags
+	n.TagLabels = notification.TagLabels
 	n.AccessToken = notification.AccessToken
 	n.AccessTokenSecret = notification.AccessTokenSecret
 	n.ConsumerKey = notification.ConsumerKey
@@ -112,6 +119,7 @@ func (r *NotificationTwitterResource) Metadata(_ context.Context, req resource.M
 func (r *NotificationTwitterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->Notification Twitter resource.\nFor more information refer to [Notification](https://wiki.servarr.com/whisparr/settings#connect) and [Twitter](https://wiki.servarr.com/whisparr/supported#twitter).",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
@@ -167,6 +175,15 @@ func (r *NotificationTwitterResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -208,6 +225,12 @@ func (r *NotificationTwitterResource) Schema(_ context.Context, _ resource.Schem
 	}
 }
 
+func (r *NotificationTwitterResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *NotificationTwitterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -224,12 +247,18 @@ func (r *NotificationTwitterResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationTwitter
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationTwitterResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Create, notificationTwitterResourceName, err)...)
 
 		return
 	}
@@ -237,6 +266,7 @@ func (r *NotificationTwitterResource) Create(ctx context.Context, req resource.C
 	tflog.Trace(ctx, "created "+notificationTwitterResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -251,9 +281,11 @@ func (r *NotificationTwitterResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get NotificationTwitter current value
-	response, _, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationApi.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationTwitterResourceName, err))
+		if !helpers.RemoveNotFound(ctx, notificationTwitterResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Read, notificationTwitterResourceName, err)...)
+		}
 
 		return
 	}
@@ -261,6 +293,7 @@ func (r *NotificationTwitterResource) Read(ctx context.Context, req resource.Rea
 	tflog.Trace(ctx, "read "+notificationTwitterResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -274,12 +307,18 @@ func (r *NotificationTwitterResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationTwitter
 	request := notification.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.NotificationApi.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationTwitterResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Update, notificationTwitterResourceName, err)...)
 
 		return
 	}
@@ -287,6 +326,7 @@ func (r *NotificationTwitterResource) Update(ctx context.Context, req resource.U
 	tflog.Trace(ctx, "updated "+notificationTwitterResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
+	notification.TagLabels = r.client.TagLabels(ctx, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -302,7 +342,7 @@ func (r *NotificationTwitterResource) Delete(ctx context.Context, req resource.D
 	// Delete NotificationTwitter current value
 	_, err := r.client.NotificationApi.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationTwitterResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseFieldClientDiagnostics(helpers.Delete, notificationTwitterResourceName, err)...)
 
 		return
 	}
@@ -316,6 +356,12 @@ func (r *NotificationTwitterResource) ImportState(ctx context.Context, req resou
 	tflog.Trace(ctx, "imported "+notificationTwitterResourceName+": "+req.ID)
 }
 
+func (r *NotificationTwitterResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.MoveFromGeneric(ctx, NewNotificationResource(), "implementation", notificationTwitterImplementation),
+	}
+}
+
 func (n *NotificationTwitter) write(ctx context.Context, notification *whisparr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,7 +22,7 @@ func NewNotificationsDataSource() datasource.DataSource {
 
 // NotificationsDataSource defines the notifications implementation.
 type NotificationsDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // Notifications describes the notifications data model.
@@ -107,6 +106,11 @@ func (d *NotificationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"tag_labels": schema.SetAttribute{
+							MarkdownDescription: "List of associated tag labels.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 						"id": schema.Int64Attribute{
 							MarkdownDescription: "Notification ID.",
 							Computed:            true,
@@ -404,16 +408,19 @@ func (d *NotificationsDataSource) Read(ctx context.Context, _ datasource.ReadReq
 	// Get notifications current value
 	response, _, err := d.client.NotificationApi.ListNotification(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, notificationsDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.List, notificationsDataSourceName, err)...)
 
 		return
 	}
 
 	tflog.Trace(ctx, "read "+notificationsDataSourceName)
+	labels := d.client.TagLabelMap(ctx, &resp.Diagnostics)
+
 	// Map response body to resource schema attribute
 	notifications := make([]Notification, len(response))
 	for i, n := range response {
 		notifications[i].write(ctx, n, &resp.Diagnostics)
+		notifications[i].TagLabels = helpers.MapTagLabels(ctx, notifications[i].Tags, labels, &resp.Diagnostics)
 	}
 
 	notificationList, diags := types.SetValueFrom(ctx, Notification{}.getType(), notifications)
@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
+	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const parseDataSourceName = "parse"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ParseDataSource{}
+
+func NewParseDataSource() datasource.DataSource {
+	return &ParseDataSource{}
+}
+
+// ParseDataSource defines the parse implementation.
+type ParseDataSource struct {
+	client *helpers.Client
+}
+
+// Parse describes the parse data model.
+type Parse struct {
+	Languages         types.Set    `tfsdk:"languages"`
+	CustomFormats     types.Set    `tfsdk:"custom_formats"`
+	Title             types.String `tfsdk:"title"`
+	MovieTitle        types.String `tfsdk:"movie_title"`
+	QualityName       types.String `tfsdk:"quality_name"`
+	Source            types.String `tfsdk:"source"`
+	Modifier          types.String `tfsdk:"modifier"`
+	ReleaseGroup      types.String `tfsdk:"release_group"`
+	Edition           types.String `tfsdk:"edition"`
+	ID                types.String `tfsdk:"id"`
+	QualityProfileID  types.Int64  `tfsdk:"quality_profile_id"`
+	Year              types.Int64  `tfsdk:"year"`
+	QualityID         types.Int64  `tfsdk:"quality_id"`
+	Resolution        types.Int64  `tfsdk:"resolution"`
+	MovieID           types.Int64  `tfsdk:"movie_id"`
+	CustomFormatScore types.Int64  `tfsdk:"custom_format_score"`
+}
+
+// ParseCustomFormat is part of Parse.
+type ParseCustomFormat struct {
+	Name  types.String `tfsdk:"name"`
+	ID    types.Int64  `tfsdk:"id"`
+	Score types.Int64  `tfsdk:"score"`
+}
+
+func (f ParseCustomFormat) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"name":  types.StringType,
+			"id":    types.Int64Type,
+			"score": types.Int64Type,
+		})
+}
+
+// parseResult adds to whisparr.ParseResource the fields not modeled by the SDK.
+type parseResult struct {
+	*whisparr.ParseResource
+	CustomFormats     []*whisparr.CustomFormatResource `json:"customFormats"`
+	CustomFormatScore int32                            `json:"customFormatScore"`
+}
+
+func (d *ParseDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + parseDataSourceName
+}
+
+func (d *ParseDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Profiles -->Parse a release title the way Whisparr does when grabbing or importing it.\n" +
+			"Custom format scores come from the given quality profile, or from the one of the matched movie.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Release title.",
+				Required:            true,
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Quality profile ID used to score the custom formats.",
+				Optional:            true,
+			},
+			"movie_title": schema.StringAttribute{
+				MarkdownDescription: "Parsed movie title.",
+				Computed:            true,
+			},
+			"year": schema.Int64Attribute{
+				MarkdownDescription: "Parsed year.",
+				Computed:            true,
+			},
+			"movie_id": schema.Int64Attribute{
+				MarkdownDescription: "Matched movie ID.",
+				Computed:            true,
+			},
+			"quality_id": schema.Int64Attribute{
+				MarkdownDescription: "Parsed quality ID.",
+				Computed:            true,
+			},
+			"quality_name": schema.StringAttribute{
+				MarkdownDescription: "Parsed quality name.",
+				Computed:            true,
+			},
+			"resolution": schema.Int64Attribute{
+				MarkdownDescription: "Parsed resolution.",
+				Computed:            true,
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Parsed source.",
+				Computed:            true,
+			},
+			"modifier": schema.StringAttribute{
+				MarkdownDescription: "Parsed modifier.",
+				Computed:            true,
+			},
+			"languages": schema.SetAttribute{
+				MarkdownDescription: "Parsed language names.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"release_group": schema.StringAttribute{
+				MarkdownDescription: "Parsed release group.",
+				Computed:            true,
+			},
+			"edition": schema.StringAttribute{
+				MarkdownDescription: "Parsed edition.",
+				Computed:            true,
+			},
+			"custom_format_score": schema.Int64Attribute{
+				MarkdownDescription: "Total custom format score.",
+				Computed:            true,
+			},
+			"custom_formats": schema.SetNestedAttribute{
+				MarkdownDescription: "Matching custom formats.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: ParseDataSource{}.getCustomFormatSchema().Attributes,
+				},
+			},
+		},
+	}
+}
+
+func (d ParseDataSource) getCustomFormatSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Custom format ID.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Custom format name.",
+				Computed:            true,
+			},
+			"score": schema.Int64Attribute{
+				MarkdownDescription: "Custom format score.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ParseDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *ParseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Parse
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get parse result
+	result := parseTitle(ctx, d.client, data.Title.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the quality profile scoring the custom formats
+	profileID := data.QualityProfileID.ValueInt64()
+	if profileID == 0 {
+		profileID = int64(result.Movie.GetQualityProfileId())
+	}
+
+	var profile *whisparr.QualityProfileResource
+
+	if profileID != 0 {
+		var err error
+
+		profile, _, err = d.client.QualityProfileApi.GetQualityProfileById(ctx, int32(profileID)).Execute()
+		if err != nil {
+			resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, qualityProfileResourceName, err)...)
+
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "read "+parseDataSourceName)
+	// Map response body to resource schema attribute
+	data.write(ctx, result, profile, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// parseTitle calls the parse endpoint, decoding also the custom formats.
+func parseTitle(ctx context.Context, client *helpers.Client, title string, diags *diag.Diagnostics) *parseResult {
+	response, httpResp, err := client.ParseApi.GetParse(ctx).Title(title).Execute()
+	if err != nil {
+		diags.Append(helpers.ParseClientDiagnostics(helpers.Read, parseDataSourceName, err)...)
+
+		return nil
+	}
+
+	defer httpResp.Body.Close()
+
+	result := &parseResult{ParseResource: response}
+	if err := json.NewDecoder(httpResp.Body).Decode(result); err != nil {
+		diags.Append(helpers.ParseClientDiagnostics(helpers.Read, parseDataSourceName, err)...)
+
+		return nil
+	}
+
+	return result
+}
+
+// formatScores maps each format ID to its score in the given profile.
+func formatScores(profile *whisparr.QualityProfileResource) map[int32]int32 {
+	scores := make(map[int32]int32, len(profile.GetFormatItems()))
+	for _, f := range profile.GetFormatItems() {
+		scores[f.GetFormat()] = f.GetScore()
+	}
+
+	return scores
+}
+
+func (p *Parse) write(ctx context.Context, result *parseResult, profile *whisparr.QualityProfileResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	info := result.GetParsedMovieInfo()
+	quality := info.GetQuality()
+
+	p.ID = types.StringValue(p.Title.ValueString())
+	p.MovieTitle = types.StringValue(info.GetPrimaryMovieTitle())
+	p.Year = types.Int64Value(int64(info.GetYear()))
+	p.MovieID = types.Int64Value(int64(result.Movie.GetId()))
+	p.QualityID = types.Int64Value(int64(quality.Quality.GetId()))
+	p.QualityName = types.StringValue(quality.Quality.GetName())
+	p.Resolution = types.Int64Value(int64(quality.Quality.GetResolution()))
+	p.Source = types.StringValue(string(quality.Quality.GetSource()))
+	p.Modifier = types.StringValue(string(quality.Quality.GetModifier()))
+	p.ReleaseGroup = types.StringValue(info.GetReleaseGroup())
+	p.Edition = types.StringValue(info.GetEdition())
+
+	languages := make([]string, len(info.GetLanguages()))
+	for i, l := range info.GetLanguages() {
+		languages[i] = l.GetName()
+	}
+
+	p.Languages, tempDiag = types.SetValueFrom(ctx, types.StringType, languages)
+	diags.Append(tempDiag...)
+
+	var scores map[int32]int32
+
+	score := result.CustomFormatScore
+	if profile != nil {
+		scores = formatScores(profile)
+		score = 0
+	}
+
+	formats := make([]ParseCustomFormat, len(result.CustomFormats))
+	for i, f := range result.CustomFormats {
+		formats[i].write(f, scores)
+		score += scores[f.GetId()]
+	}
+
+	p.CustomFormatScore = types.Int64Value(int64(score))
+	p.CustomFormats, tempDiag = types.SetValueFrom(ctx, ParseCustomFormat{}.getType(), formats)
+	diags.Append(tempDiag...)
+}
+
+func (f *ParseCustomFormat) write(format *whisparr.CustomFormatResource, scores map[int32]int32) {
+	f.ID = types.Int64Value(int64(format.GetId()))
+	f.Name = types.StringValue(format.GetName())
+	f.Score = types.Int64Value(int64(scores[format.GetId()]))
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccParseDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccParseDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccParseDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.whisparr_parse.test", "movie_title", "Deep Throat"),
+					resource.TestCheckResourceAttr("data.whisparr_parse.test", "year", "1972"),
+					resource.TestCheckResourceAttr("data.whisparr_parse.test", "quality_name", "Bluray-1080p"),
+					resource.TestCheckResourceAttr("data.whisparr_parse.test", "resolution", "1080"),
+					resource.TestCheckResourceAttr("data.whisparr_parse.test", "release_group", "GROUP"),
+				),
+			},
+		},
+	})
+}
+
+const testAccParseDataSourceConfig = `
+data "whisparr_parse" "test" {
+	title = "Deep.Throat.1972.1080p.BluRay.x264-GROUP"
+	quality_profile_id = 1
+}
+`
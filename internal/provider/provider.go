@@ -2,13 +2,19 @@ package provider
 
 import (
 	"context"
+	"net/http"
 	"os"
 
+	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -28,6 +34,18 @@ type WhisparrProvider struct {
 
 // Whisparr describes the provider data model.
 type Whisparr struct {
+	Instances      types.Map     `tfsdk:"instances"`
+	PerSecond      types.Float64 `tfsdk:"requests_per_second"`
+	APIKey         types.String  `tfsdk:"api_key"`
+	URL            types.String  `tfsdk:"url"`
+	MaxConcurrent  types.Int64   `tfsdk:"max_concurrent_requests"`
+	AutoCreateTags types.Bool    `tfsdk:"auto_create_tags"`
+	AdoptExisting  types.Bool    `tfsdk:"adopt_existing"`
+	CacheList      types.Bool    `tfsdk:"cache_list_responses"`
+}
+
+// Instance describes an additional Whisparr instance.
+type Instance struct {
 	APIKey types.String `tfsdk:"api_key"`
 	URL    types.String `tfsdk:"url"`
 }
@@ -50,6 +68,49 @@ func (p *WhisparrProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 				MarkdownDescription: "Full Whisparr URL with protocol and port (e.g. `https://test.whisparr.tv:6969`). You should **NOT** supply any path (`/api`), the SDK will use the appropriate paths. Can be specified via the `WHISPARR_URL` environment variable.",
 				Optional:            true,
 			},
+			"auto_create_tags": schema.BoolAttribute{
+				MarkdownDescription: "Create the tags referenced by `tag_labels` when they do not exist yet. Defaults to `false`.",
+				Optional:            true,
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over the objects already existing with the same natural key (tag label, root folder path, custom format name, remote path mapping host and remote path) instead of failing on create. Defaults to `false`, each resource can override it.",
+				Optional:            true,
+			},
+			"cache_list_responses": schema.BoolAttribute{
+				MarkdownDescription: "Cache the list endpoint responses of each instance for the whole run, any write request clears the cache. Defaults to `false`.",
+				Optional:            true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of concurrent requests to each instance, useful to avoid SQLite `database is locked` errors. Defaults to unlimited.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of requests per second to each instance. Defaults to unlimited.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.1),
+				},
+			},
+			"instances": schema.MapNestedAttribute{
+				MarkdownDescription: "Additional Whisparr instances, selected by name through the `instance` attribute of resources and data sources.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							MarkdownDescription: "Full Whisparr URL with protocol and port.",
+							Required:            true,
+						},
+						"api_key": schema.StringAttribute{
+							MarkdownDescription: "API key for Whisparr authentication.",
+							Required:            true,
+							Sensitive:           true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -119,18 +180,117 @@ func (p *WhisparrProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
-	// Configuring client. API Key management could be changed once new options avail in sdk.
+	// Additional instances
+	if data.Instances.IsUnknown() {
+		// Cannot connect to client with an unknown value
+		resp.Diagnostics.AddWarning(
+			"Unable to create client",
+			"Cannot use unknown value as instances",
+		)
+
+		return
+	}
+
+	instances := make(map[string]Instance, len(data.Instances.Elements()))
+	resp.Diagnostics.Append(data.Instances.ElementsAs(ctx, &instances, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, instance := range instances {
+		if instance.URL.IsUnknown() || instance.APIKey.IsUnknown() {
+			// Cannot connect to client with an unknown value
+			resp.Diagnostics.AddWarning(
+				"Unable to create client",
+				"Cannot use unknown value as url or api_key of instance "+name,
+			)
+
+			return
+		}
+
+		// Error vs warning - empty value must stop execution
+		if instance.URL.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("instances").AtMapKey(name).AtName("url"),
+				"Unable to find URL",
+				"URL cannot be an empty string",
+			)
+		}
+
+		if instance.APIKey.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("instances").AtMapKey(name).AtName("api_key"),
+				"Unable to find API key",
+				"API key cannot be an empty string",
+			)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Requests are logged hiding the values of the sensitive attributes.
+	logging := helpers.NewLoggingTransport(http.DefaultTransport, p.sensitiveAttributes(ctx))
+
+	registry := &helpers.Registry{
+		Default:   newClient(url, key, &data, logging),
+		Instances: make(map[string]*helpers.Client, len(instances)),
+	}
+
+	for name, instance := range instances {
+		registry.Instances[name] = newClient(instance.URL.ValueString(), instance.APIKey.ValueString(), &data, logging)
+	}
+
+	resp.DataSourceData = registry
+	resp.ResourceData = registry
+}
+
+// newClient configures the client of a single instance.
+func newClient(url, key string, data *Whisparr, logging http.RoundTripper) *helpers.Client {
+	// API Key management could be changed once new options avail in sdk.
 	config := whisparr.NewConfiguration()
 	config.AddDefaultHeader("X-Api-Key", key)
 	config.Servers[0].URL = url
-	client := whisparr.NewAPIClient(config)
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	// Requests are limited and retried on database locks before reaching the cache.
+	var transport http.RoundTripper = helpers.NewLimitTransport(logging, data.MaxConcurrent.ValueInt64(), data.PerSecond.ValueFloat64())
+	transport = &helpers.RetryTransport{Transport: transport}
+
+	if data.CacheList.ValueBool() {
+		transport = helpers.NewCacheTransport(transport)
+	}
+
+	config.HTTPClient = &http.Client{Transport: transport}
+
+	return &helpers.Client{
+		APIClient:      whisparr.NewAPIClient(config),
+		AutoCreateTags: data.AutoCreateTags.ValueBool(),
+		AdoptExisting:  data.AdoptExisting.ValueBool(),
+	}
+}
+
+// sensitiveAttributes lists the names of the attributes marked as sensitive in the resource schemas.
+func (p *WhisparrProvider) sensitiveAttributes(ctx context.Context) []string {
+	names := []string{}
+
+	for _, newResource := range p.Resources(ctx) {
+		resp := &resource.SchemaResponse{}
+		newResource().Schema(ctx, resource.SchemaRequest{}, resp)
+
+		for name, attribute := range resp.Schema.Attributes {
+			if attribute.IsSensitive() {
+				names = append(names, name)
+			}
+		}
+	}
+
+	return names
 }
 
 func (p *WhisparrProvider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	return helpers.InstanceResources([]func() resource.Resource{
 		// Download Clients
 		NewDownloadClientConfigResource,
 		NewDownloadClientResource,
@@ -195,6 +355,8 @@ func (p *WhisparrProvider) Resources(_ context.Context) []func() resource.Resour
 		NewMediaManagementResource,
 		NewNamingResource,
 		NewRootFolderResource,
+		NewRootFolderImportResource,
+		NewManualImportResource,
 
 		// Metadata
 		NewMetadataResource,
@@ -206,6 +368,7 @@ func (p *WhisparrProvider) Resources(_ context.Context) []func() resource.Resour
 
 		// Movies
 		NewMovieResource,
+		NewRenameResource,
 
 		// Notifications
 		NewNotificationResource,
@@ -235,16 +398,18 @@ func (p *WhisparrProvider) Resources(_ context.Context) []func() resource.Resour
 		// Profiles
 		NewCustomFormatResource,
 		NewDelayProfileResource,
+		NewDelayProfileOrderResource,
 		NewQualityProfileResource,
 		NewQualityDefinitionResource,
+		NewQualityDefinitionsResource,
 
 		// Tags
 		NewTagResource,
-	}
+	})
 }
 
 func (p *WhisparrProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{
+	return helpers.InstanceDataSources([]func() datasource.DataSource{
 		// Download Clients
 		NewDownloadClientConfigDataSource,
 		NewDownloadClientDataSource,
@@ -269,8 +434,10 @@ func (p *WhisparrProvider) DataSources(_ context.Context) []func() datasource.Da
 		// Media Management
 		NewMediaManagementDataSource,
 		NewNamingDataSource,
+		NewNamingExamplesDataSource,
 		NewRootFolderDataSource,
 		NewRootFoldersDataSource,
+		NewManualImportCandidatesDataSource,
 
 		// Metadata
 		NewMetadataConfigDataSource,
@@ -280,6 +447,7 @@ func (p *WhisparrProvider) DataSources(_ context.Context) []func() datasource.Da
 		// Movies
 		NewMovieDataSource,
 		NewMoviesDataSource,
+		NewRenamePreviewDataSource,
 
 		// Notifications
 		NewNotificationDataSource,
@@ -292,6 +460,8 @@ func (p *WhisparrProvider) DataSources(_ context.Context) []func() datasource.Da
 		NewDelayProfilesDataSource,
 		NewQualityDataSource,
 		NewQualityProfileDataSource,
+		NewParseDataSource,
+		NewReleaseScoreDataSource,
 		NewQualityProfilesDataSource,
 		NewQualityDefinitionDataSource,
 		NewQualityDefinitionsDataSource,
@@ -313,7 +483,9 @@ func (p *WhisparrProvider) DataSources(_ context.Context) []func() datasource.Da
 		// Tags
 		NewTagDataSource,
 		NewTagsDataSource,
-	}
+		NewTagDetailsDataSource,
+		NewTagsDetailsDataSource,
+	})
 }
 
 // New returns the provider with a specific version.
@@ -1,12 +1,17 @@
 package provider
 
 import (
+	"context"
 	"os"
 	"testing"
 
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -43,3 +48,63 @@ provider "whisparr" {
 	api_key = "ErrorAPIKey"
   }
 `
+
+func TestProviderConfigureInstances(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		url    string
+		apiKey string
+		err    bool
+	}{
+		"valid": {
+			url:    "http://localhost:6969",
+			apiKey: "key",
+		},
+		"empty url": {
+			apiKey: "key",
+			err:    true,
+		},
+		"empty api key": {
+			url: "http://localhost:6969",
+			err: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			p := New("test")()
+			schemaResp := &provider.SchemaResponse{}
+			p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+
+			configType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			instanceType := configType.AttributeTypes["instances"].(tftypes.Map).ElementType
+
+			values := make(map[string]tftypes.Value, len(configType.AttributeTypes))
+			for attribute, attributeType := range configType.AttributeTypes {
+				values[attribute] = tftypes.NewValue(attributeType, nil)
+			}
+
+			values["url"] = tftypes.NewValue(tftypes.String, "http://localhost:6969")
+			values["api_key"] = tftypes.NewValue(tftypes.String, "key")
+			values["instances"] = tftypes.NewValue(configType.AttributeTypes["instances"], map[string]tftypes.Value{
+				"other": tftypes.NewValue(instanceType, map[string]tftypes.Value{
+					"url":     tftypes.NewValue(tftypes.String, test.url),
+					"api_key": tftypes.NewValue(tftypes.String, test.apiKey),
+				}),
+			})
+
+			resp := &provider.ConfigureResponse{}
+			p.Configure(ctx, provider.ConfigureRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(configType, values)},
+			}, resp)
+
+			assert.Equal(t, test.err, resp.Diagnostics.HasError())
+			assert.Equal(t, test.err, resp.ResourceData == nil)
+		})
+	}
+}
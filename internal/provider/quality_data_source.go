@@ -24,7 +24,7 @@ func NewQualityDataSource() datasource.DataSource {
 
 // QualityDataSource defines the quality implementation.
 type QualityDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // Quality is part of QualityGroup.
@@ -91,7 +91,7 @@ func (d *QualityDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	// Get qualitys current value
 	response, _, err := d.client.QualityDefinitionApi.ListQualityDefinition(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, qualityDataSourceName, err)...)
 
 		return
 	}
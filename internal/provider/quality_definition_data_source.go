@@ -23,7 +23,7 @@ func NewQualityDefinitionDataSource() datasource.DataSource {
 
 // QualityDefinitionDataSource defines the quality definitions implementation.
 type QualityDefinitionDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *QualityDefinitionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -93,7 +93,7 @@ func (d *QualityDefinitionDataSource) Read(ctx context.Context, req datasource.R
 	// Get qualitydefinitions current value
 	response, _, err := d.client.QualityDefinitionApi.ListQualityDefinition(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDefinitionDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, qualityDefinitionDataSourceName, err)...)
 
 		return
 	}
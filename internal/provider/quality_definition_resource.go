@@ -31,7 +31,7 @@ func NewQualityDefinitionResource() resource.Resource {
 
 // QualityDefinitionResource defines the quality definition implementation.
 type QualityDefinitionResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // QualityDefinition describes the quality definition data model.
@@ -141,7 +141,7 @@ func (r *QualityDefinitionResource) Create(ctx context.Context, req resource.Cre
 	// Read to get the quality ID
 	read, _, err := r.client.QualityDefinitionApi.GetQualityDefinitionById(ctx, request.GetId()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, qualityDefinitionResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Create, qualityDefinitionResourceName, err)...)
 
 		return
 	}
@@ -152,7 +152,7 @@ func (r *QualityDefinitionResource) Create(ctx context.Context, req resource.Cre
 	// Create new QualityDefinition
 	response, _, err := r.client.QualityDefinitionApi.UpdateQualityDefinition(ctx, strconv.Itoa(int(request.GetId()))).QualityDefinitionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, qualityDefinitionResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Create, qualityDefinitionResourceName, err)...)
 
 		return
 	}
@@ -174,9 +174,11 @@ func (r *QualityDefinitionResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get qualitydefinition current value
-	response, _, err := r.client.QualityDefinitionApi.GetQualityDefinitionById(ctx, int32(definition.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.QualityDefinitionApi.GetQualityDefinitionById(ctx, int32(definition.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDefinitionResourceName, err))
+		if !helpers.RemoveNotFound(ctx, qualityDefinitionResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, qualityDefinitionResourceName, err)...)
+		}
 
 		return
 	}
@@ -203,7 +205,7 @@ func (r *QualityDefinitionResource) Update(ctx context.Context, req resource.Upd
 	// Update QualityDefinition
 	response, _, err := r.client.QualityDefinitionApi.UpdateQualityDefinition(ctx, strconv.Itoa(int(request.GetId()))).QualityDefinitionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, qualityDefinitionResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Update, qualityDefinitionResourceName, err)...)
 
 		return
 	}
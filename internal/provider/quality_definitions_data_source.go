@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,7 +22,7 @@ func NewQualityDefinitionsDataSource() datasource.DataSource {
 
 // QualityDefinitionsDataSource defines the qyality definitions implementation.
 type QualityDefinitionsDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // QualityDefinitions describes the qyality definitions data model.
@@ -104,7 +103,7 @@ func (d *QualityDefinitionsDataSource) Read(ctx context.Context, _ datasource.Re
 	// Get qualitydefinitions current value
 	response, _, err := d.client.QualityDefinitionApi.ListQualityDefinition(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDefinitionsDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, qualityDefinitionsDataSourceName, err)...)
 
 		return
 	}
@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
+	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const qualityDefinitionsResourceName = "quality_definitions"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &QualityDefinitionsResource{}
+
+func NewQualityDefinitionsResource() resource.Resource {
+	return &QualityDefinitionsResource{}
+}
+
+// QualityDefinitionsResource defines the quality definitions implementation.
+type QualityDefinitionsResource struct {
+	client *helpers.Client
+}
+
+// QualityDefinitionsTable describes the quality definitions data model.
+type QualityDefinitionsTable struct {
+	Definitions types.Map    `tfsdk:"definitions"`
+	ID          types.String `tfsdk:"id"`
+	ClearOthers types.Bool   `tfsdk:"clear_others"`
+}
+
+// QualityDefinitionsItem is part of QualityDefinitionsTable.
+type QualityDefinitionsItem struct {
+	MinSize       types.Float64 `tfsdk:"min_size"`
+	MaxSize       types.Float64 `tfsdk:"max_size"`
+	PreferredSize types.Float64 `tfsdk:"preferred_size"`
+	Title         types.String  `tfsdk:"title"`
+	ID            types.Int64   `tfsdk:"id"`
+}
+
+func (q QualityDefinitionsItem) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"min_size":       types.Float64Type,
+			"max_size":       types.Float64Type,
+			"preferred_size": types.Float64Type,
+			"title":          types.StringType,
+			"id":             types.Int64Type,
+		})
+}
+
+func (r *QualityDefinitionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + qualityDefinitionsResourceName
+}
+
+func (r *QualityDefinitionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Profiles -->Quality Definitions resource.\nManages all the [Quality Definitions](quality_definition) with a single bulk update, do not use together with `whisparr_quality_definition`.\n" +
+			"For more information refer to [Quality Definition](https://wiki.servarr.com/whisparr/settings#quality-1) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"clear_others": schema.BoolAttribute{
+				MarkdownDescription: "Clear the definitions not listed in `definitions`, setting their quality name as title, no minimum, no preferred and no maximum size. These are not the Whisparr defaults, which are not exposed by the API. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"definitions": schema.MapNestedAttribute{
+				MarkdownDescription: "Quality definitions by quality name.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Quality Definition ID.",
+							Computed:            true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Quality Definition Title.",
+							Optional:            true,
+							Computed:            true,
+						},
+						"min_size": schema.Float64Attribute{
+							MarkdownDescription: "Minimum size MB/min.",
+							Optional:            true,
+							Computed:            true,
+						},
+						"max_size": schema.Float64Attribute{
+							MarkdownDescription: "Maximum size MB/min.",
+							Optional:            true,
+							Computed:            true,
+						},
+						"preferred_size": schema.Float64Attribute{
+							MarkdownDescription: "Preferred size MB/min.",
+							Optional:            true,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *QualityDefinitionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *QualityDefinitionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var definitions *QualityDefinitionsTable
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &definitions)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new QualityDefinitions
+	r.update(ctx, definitions, helpers.Create, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+qualityDefinitionsResourceName+": "+definitions.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &definitions)...)
+}
+
+func (r *QualityDefinitionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var definitions *QualityDefinitionsTable
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &definitions)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get qualitydefinitions current value
+	response, _, err := r.client.QualityDefinitionApi.ListQualityDefinition(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, qualityDefinitionsResourceName, err)...)
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+qualityDefinitionsResourceName)
+	// Map response body to resource schema attribute
+	definitions.write(ctx, response, &resp.Diagnostics)
+	definitions.checkOthers(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &definitions)...)
+}
+
+func (r *QualityDefinitionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var definitions *QualityDefinitionsTable
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &definitions)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update QualityDefinitions
+	r.update(ctx, definitions, helpers.Update, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+qualityDefinitionsResourceName+": "+definitions.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &definitions)...)
+}
+
+func (r *QualityDefinitionsResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// QualityDefinitions cannot be really deleted just removing configuration
+	tflog.Trace(ctx, "decoupled "+qualityDefinitionsResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+// update sends the whole quality definition table with the bulk endpoint and reads it back.
+func (r *QualityDefinitionsResource) update(ctx context.Context, definitions *QualityDefinitionsTable, action string, diags *diag.Diagnostics) {
+	current, _, err := r.client.QualityDefinitionApi.ListQualityDefinition(ctx).Execute()
+	if err != nil {
+		diags.Append(helpers.ParseClientDiagnostics(action, qualityDefinitionsResourceName, err)...)
+
+		return
+	}
+
+	request := definitions.read(ctx, current, diags)
+	if diags.HasError() {
+		return
+	}
+
+	_, err = r.client.QualityDefinitionApi.PutQualityDefinitionUpdate(ctx).QualityDefinitionResource(request).Execute()
+	if err != nil {
+		diags.Append(helpers.ParseClientDiagnostics(action, qualityDefinitionsResourceName, err)...)
+
+		return
+	}
+
+	// Bulk update has no response body
+	response, _, err := r.client.QualityDefinitionApi.ListQualityDefinition(ctx).Execute()
+	if err != nil {
+		diags.Append(helpers.ParseClientDiagnostics(helpers.Read, qualityDefinitionsResourceName, err)...)
+
+		return
+	}
+
+	definitions.write(ctx, response, diags)
+}
+
+func (q *QualityDefinitionsTable) write(ctx context.Context, definitions []*whisparr.QualityDefinitionResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	items := make(map[string]QualityDefinitionsItem, len(q.Definitions.Elements()))
+	diags.Append(q.Definitions.ElementsAs(ctx, &items, false)...)
+
+	for _, d := range definitions {
+		name := d.Quality.GetName()
+		if _, ok := items[name]; ok {
+			items[name] = QualityDefinitionsItem{
+				ID:            types.Int64Value(int64(d.GetId())),
+				Title:         types.StringValue(d.GetTitle()),
+				MinSize:       types.Float64Value(d.GetMinSize()),
+				MaxSize:       types.Float64Value(d.GetMaxSize()),
+				PreferredSize: types.Float64Value(d.GetPreferredSize()),
+			}
+		}
+	}
+
+	if q.ClearOthers.IsNull() || q.ClearOthers.IsUnknown() {
+		q.ClearOthers = types.BoolValue(false)
+	}
+
+	q.ID = types.StringValue(strconv.Itoa(len(items)))
+	q.Definitions, tempDiag = types.MapValueFrom(ctx, QualityDefinitionsItem{}.getType(), items)
+	diags.Append(tempDiag...)
+}
+
+// checkOthers flags as not cleared the unlisted definitions changed outside terraform, to trigger a new clear.
+func (q *QualityDefinitionsTable) checkOthers(ctx context.Context, definitions []*whisparr.QualityDefinitionResource, diags *diag.Diagnostics) {
+	if !q.ClearOthers.ValueBool() {
+		return
+	}
+
+	items := make(map[string]QualityDefinitionsItem, len(q.Definitions.Elements()))
+	diags.Append(q.Definitions.ElementsAs(ctx, &items, false)...)
+
+	for _, d := range definitions {
+		if _, ok := items[d.Quality.GetName()]; ok {
+			continue
+		}
+
+		if d.GetTitle() != d.Quality.GetName() || d.GetMinSize() != 0 || d.GetMaxSize() != 0 || d.GetPreferredSize() != 0 {
+			q.ClearOthers = types.BoolValue(false)
+
+			return
+		}
+	}
+}
+
+func (q *QualityDefinitionsTable) read(ctx context.Context, current []*whisparr.QualityDefinitionResource, diags *diag.Diagnostics) []whisparr.QualityDefinitionResource {
+	items := make(map[string]QualityDefinitionsItem, len(q.Definitions.Elements()))
+	diags.Append(q.Definitions.ElementsAs(ctx, &items, false)...)
+
+	found := make(map[string]bool, len(items))
+	definitions := make([]whisparr.QualityDefinitionResource, len(current))
+
+	for n, d := range current {
+		name := d.Quality.GetName()
+		definitions[n] = *d
+
+		if item, ok := items[name]; ok {
+			item.read(&definitions[n])
+
+			found[name] = true
+
+			continue
+		}
+
+		if q.ClearOthers.ValueBool() {
+			definitions[n].SetTitle(name)
+			definitions[n].SetMinSize(0)
+			definitions[n].SetMaxSizeNil()
+			definitions[n].SetPreferredSizeNil()
+		}
+	}
+
+	for name := range items {
+		if !found[name] {
+			diags.AddError(helpers.ResourceError, helpers.ParseNotFoundError(qualityDefinitionsResourceName, "quality name", name))
+		}
+	}
+
+	return definitions
+}
+
+func (q *QualityDefinitionsItem) read(definition *whisparr.QualityDefinitionResource) {
+	if !q.Title.IsNull() && !q.Title.IsUnknown() {
+		definition.SetTitle(q.Title.ValueString())
+	}
+
+	if !q.MinSize.IsNull() && !q.MinSize.IsUnknown() {
+		definition.SetMinSize(q.MinSize.ValueFloat64())
+	}
+
+	if !q.MaxSize.IsNull() && !q.MaxSize.IsUnknown() {
+		definition.SetMaxSize(q.MaxSize.ValueFloat64())
+	}
+
+	if !q.PreferredSize.IsNull() && !q.PreferredSize.IsUnknown() {
+		definition.SetPreferredSize(q.PreferredSize.ValueFloat64())
+	}
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQualityDefinitionsCheckOthers(t *testing.T) {
+	t.Parallel()
+
+	cleared := func() *whisparr.QualityDefinitionResource {
+		definition := whisparr.NewQualityDefinitionResource()
+		definition.SetQuality(whisparr.Quality{Name: *whisparr.NewNullableString(whisparr.PtrString("SDTV"))})
+		definition.SetTitle("SDTV")
+		definition.SetMinSize(0)
+
+		return definition
+	}
+
+	tests := map[string]struct {
+		definition *whisparr.QualityDefinitionResource
+		expected   bool
+	}{
+		"nil sizes": {
+			definition: cleared(),
+			expected:   true,
+		},
+		"zero sizes": {
+			definition: func() *whisparr.QualityDefinitionResource {
+				d := cleared()
+				d.SetMaxSize(0)
+				d.SetPreferredSize(0)
+
+				return d
+			}(),
+			expected: true,
+		},
+		"changed size": {
+			definition: func() *whisparr.QualityDefinitionResource {
+				d := cleared()
+				d.SetMaxSize(100)
+
+				return d
+			}(),
+			expected: false,
+		},
+		"changed title": {
+			definition: func() *whisparr.QualityDefinitionResource {
+				d := cleared()
+				d.SetTitle("SD")
+
+				return d
+			}(),
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			table := QualityDefinitionsTable{
+				Definitions: types.MapValueMust(QualityDefinitionsItem{}.getType(), map[string]attr.Value{}),
+				ClearOthers: types.BoolValue(true),
+			}
+			table.checkOthers(context.Background(), []*whisparr.QualityDefinitionResource{test.definition}, &diags)
+
+			assert.False(t, diags.HasError())
+			assert.Equal(t, test.expected, table.ClearOthers.ValueBool())
+		})
+	}
+}
+
+func TestAccQualityDefinitionsResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccQualityDefinitionsResourceConfig("error") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccQualityDefinitionsResourceConfig("example-1080p"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("whisparr_quality_definitions.test", "definitions.Bluray-1080p.title", "example-1080p"),
+					resource.TestCheckResourceAttr("whisparr_quality_definitions.test", "definitions.WEBDL-1080p.min_size", "20"),
+					resource.TestCheckResourceAttrSet("whisparr_quality_definitions.test", "definitions.WEBDL-1080p.id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccQualityDefinitionsResourceConfig("error") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccQualityDefinitionsResourceConfig("example-HD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("whisparr_quality_definitions.test", "definitions.Bluray-1080p.title", "example-HD"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccQualityDefinitionsResourceConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "whisparr_quality_definitions" "test" {
+		definitions = {
+			"Bluray-1080p" = {
+				title    = "%s"
+				min_size = 30.0
+				max_size = 300
+			}
+			"WEBDL-1080p" = {
+				min_size       = 20.0
+				preferred_size = 100
+			}
+		}
+	}
+	`, name)
+}
@@ -2,12 +2,16 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -22,7 +26,7 @@ func NewQualityProfileDataSource() datasource.DataSource {
 
 // QualityProfileDataSource defines the quality profiles implementation.
 type QualityProfileDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *QualityProfileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -58,6 +62,39 @@ func (d *QualityProfileDataSource) Schema(_ context.Context, _ datasource.Schema
 				MarkdownDescription: "Min format score.",
 				Computed:            true,
 			},
+			"default_format_score": schema.Int64Attribute{
+				MarkdownDescription: "Default format score, only set on the resource.",
+				Computed:            true,
+			},
+			"cutoff_name": schema.StringAttribute{
+				MarkdownDescription: "Quality or quality group name to which cutoff.",
+				Computed:            true,
+			},
+			"qualities": schema.ListNestedAttribute{
+				MarkdownDescription: "Qualities and quality groups by name, from the least to the most preferred.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Quality name, or quality group name if `members` is set.",
+							Computed:            true,
+						},
+						"allowed": schema.BoolAttribute{
+							MarkdownDescription: "Allowed flag.",
+							Computed:            true,
+						},
+						"members": schema.ListAttribute{
+							MarkdownDescription: "Quality names in group.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "Quality Profile JSON export, same format as the Whisparr UI.",
+				Computed:            true,
+			},
 			"language": schema.SingleNestedAttribute{
 				MarkdownDescription: "Language.",
 				Computed:            true,
@@ -143,7 +180,7 @@ func (d *QualityProfileDataSource) Configure(ctx context.Context, req datasource
 }
 
 func (d *QualityProfileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data *QualityProfile
+	var data *ExportedQualityProfile
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
@@ -153,7 +190,7 @@ func (d *QualityProfileDataSource) Read(ctx context.Context, req datasource.Read
 	// Get qualityprofiles current value
 	response, _, err := d.client.QualityProfileApi.ListQualityProfile(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityProfileDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, qualityProfileDataSourceName, err)...)
 
 		return
 	}
@@ -165,7 +202,64 @@ func (d *QualityProfileDataSource) Read(ctx context.Context, req datasource.Read
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (p *QualityProfile) find(ctx context.Context, name string, profiles []*whisparr.QualityProfileResource, diags *diag.Diagnostics) {
+// ExportedQualityProfile describes the quality profile data source data model, including its JSON export.
+type ExportedQualityProfile struct {
+	QualityGroups      types.Set    `tfsdk:"quality_groups"`
+	Qualities          types.List   `tfsdk:"qualities"`
+	FormatItems        types.Set    `tfsdk:"format_items"`
+	Name               types.String `tfsdk:"name"`
+	CutoffName         types.String `tfsdk:"cutoff_name"`
+	JSON               types.String `tfsdk:"json"`
+	Language           types.Object `tfsdk:"language"`
+	ID                 types.Int64  `tfsdk:"id"`
+	Cutoff             types.Int64  `tfsdk:"cutoff"`
+	MinFormatScore     types.Int64  `tfsdk:"min_format_score"`
+	CutoffFormatScore  types.Int64  `tfsdk:"cutoff_format_score"`
+	DefaultFormatScore types.Int64  `tfsdk:"default_format_score"`
+	UpgradeAllowed     types.Bool   `tfsdk:"upgrade_allowed"`
+}
+
+func (p ExportedQualityProfile) getType() attr.Type {
+	attributes := QualityProfile{}.getType().(attr.TypeWithAttributeTypes).AttributeTypes()
+	attributes["json"] = types.StringType
+
+	return types.ObjectType{}.WithAttributeTypes(attributes)
+}
+
+func (p *ExportedQualityProfile) write(ctx context.Context, profile *whisparr.QualityProfileResource, diags *diag.Diagnostics) {
+	var state QualityProfile
+
+	state.write(ctx, profile, diags)
+
+	p.QualityGroups = state.QualityGroups
+	p.Qualities = state.Qualities
+	p.FormatItems = state.FormatItems
+	p.Name = state.Name
+	p.CutoffName = state.CutoffName
+	p.Language = state.Language
+	p.ID = state.ID
+	p.Cutoff = state.Cutoff
+	p.MinFormatScore = state.MinFormatScore
+	p.CutoffFormatScore = state.CutoffFormatScore
+	p.DefaultFormatScore = state.DefaultFormatScore
+	p.UpgradeAllowed = state.UpgradeAllowed
+	p.JSON = types.StringValue(exportQualityProfileJSON(profile, diags))
+}
+
+// exportQualityProfileJSON returns the profile as exported by the Whisparr UI, without ID.
+func exportQualityProfileJSON(profile *whisparr.QualityProfileResource, diags *diag.Diagnostics) string {
+	export := *profile
+	export.Id = nil
+
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		diags.AddError(helpers.DataSourceError, fmt.Sprintf("Unable to export %s json, got error: %s", qualityProfileDataSourceName, err))
+	}
+
+	return string(data)
+}
+
+func (p *ExportedQualityProfile) find(ctx context.Context, name string, profiles []*whisparr.QualityProfileResource, diags *diag.Diagnostics) {
 	for _, profile := range profiles {
 		if profile.GetName() == name {
 			p.write(ctx, profile, diags)
@@ -30,7 +30,9 @@ func TestAccQualityProfileDataSource(t *testing.T) {
 				Config: testAccQualityProfileDataSourceConfig("Any"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.whisparr_quality_profile.test", "id"),
-					resource.TestCheckResourceAttr("data.whisparr_quality_profile.test", "language.id", "1")),
+					resource.TestCheckResourceAttr("data.whisparr_quality_profile.test", "language.id", "1"),
+					resource.TestCheckResourceAttrSet("data.whisparr_quality_profile.test", "json"),
+					resource.TestCheckResourceAttrSet("data.whisparr_quality_profile.test", "qualities.0.name")),
 			},
 		},
 	})
@@ -6,6 +6,9 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -14,17 +17,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-const qualityProfileResourceName = "quality_profile"
+const (
+	qualityProfileResourceName = "quality_profile"
+	qualityGroupStartID        = 1000
+)
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &QualityProfileResource{}
-	_ resource.ResourceWithImportState = &QualityProfileResource{}
+	_ resource.Resource                 = &QualityProfileResource{}
+	_ resource.ResourceWithImportState  = &QualityProfileResource{}
+	_ resource.ResourceWithUpgradeState = &QualityProfileResource{}
 )
 
 func NewQualityProfileResource() resource.Resource {
@@ -33,34 +41,40 @@ func NewQualityProfileResource() resource.Resource {
 
 // QualityProfileResource defines the quality profile implementation.
 type QualityProfileResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // QualityProfile describes the quality profile data model.
 type QualityProfile struct {
-	QualityGroups     types.Set    `tfsdk:"quality_groups"`
-	FormatItems       types.Set    `tfsdk:"format_items"`
-	Name              types.String `tfsdk:"name"`
-	Language          types.Object `tfsdk:"language"`
-	ID                types.Int64  `tfsdk:"id"`
-	Cutoff            types.Int64  `tfsdk:"cutoff"`
-	MinFormatScore    types.Int64  `tfsdk:"min_format_score"`
-	CutoffFormatScore types.Int64  `tfsdk:"cutoff_format_score"`
-	UpgradeAllowed    types.Bool   `tfsdk:"upgrade_allowed"`
+	QualityGroups      types.Set    `tfsdk:"quality_groups"`
+	Qualities          types.List   `tfsdk:"qualities"`
+	FormatItems        types.Set    `tfsdk:"format_items"`
+	Name               types.String `tfsdk:"name"`
+	CutoffName         types.String `tfsdk:"cutoff_name"`
+	Language           types.Object `tfsdk:"language"`
+	ID                 types.Int64  `tfsdk:"id"`
+	Cutoff             types.Int64  `tfsdk:"cutoff"`
+	MinFormatScore     types.Int64  `tfsdk:"min_format_score"`
+	CutoffFormatScore  types.Int64  `tfsdk:"cutoff_format_score"`
+	DefaultFormatScore types.Int64  `tfsdk:"default_format_score"`
+	UpgradeAllowed     types.Bool   `tfsdk:"upgrade_allowed"`
 }
 
 func (p QualityProfile) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
-			"quality_groups":      types.SetType{}.WithElementType(QualityGroup{}.getType()),
-			"format_items":        types.SetType{}.WithElementType(FormatItem{}.getType()),
-			"language":            QualityLanguage{}.getType(),
-			"name":                types.StringType,
-			"id":                  types.Int64Type,
-			"cutoff":              types.Int64Type,
-			"min_format_score":    types.Int64Type,
-			"cutoff_format_score": types.Int64Type,
-			"upgrade_allowed":     types.BoolType,
+			"quality_groups":       types.SetType{}.WithElementType(QualityGroup{}.getType()),
+			"format_items":         types.SetType{}.WithElementType(FormatItem{}.getType()),
+			"qualities":            types.ListType{}.WithElementType(QualityItem{}.getType()),
+			"language":             QualityLanguage{}.getType(),
+			"name":                 types.StringType,
+			"cutoff_name":          types.StringType,
+			"id":                   types.Int64Type,
+			"cutoff":               types.Int64Type,
+			"min_format_score":     types.Int64Type,
+			"cutoff_format_score":  types.Int64Type,
+			"default_format_score": types.Int64Type,
+			"upgrade_allowed":      types.BoolType,
 		})
 }
 
@@ -80,6 +94,22 @@ func (q QualityGroup) getType() attr.Type {
 		})
 }
 
+// QualityItem is part of QualityProfile.
+type QualityItem struct {
+	Members types.List   `tfsdk:"members"`
+	Name    types.String `tfsdk:"name"`
+	Allowed types.Bool   `tfsdk:"allowed"`
+}
+
+func (q QualityItem) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"members": types.ListType{}.WithElementType(types.StringType),
+			"name":    types.StringType,
+			"allowed": types.BoolType,
+		})
+}
+
 // FormatItem is part of QualityProfile.
 type FormatItem struct {
 	Name   types.String `tfsdk:"name"`
@@ -117,6 +147,7 @@ func (r *QualityProfileResource) Metadata(_ context.Context, req resource.Metada
 func (r *QualityProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Profiles -->Quality Profile resource.\nFor more information refer to [Quality Profile](https://wiki.servarr.com/whisparr/settings#quality-profiles) documentation.",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Quality Profile ID.",
@@ -139,6 +170,14 @@ func (r *QualityProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Optional:            true,
 				Computed:            true,
 			},
+			"cutoff_name": schema.StringAttribute{
+				MarkdownDescription: "Quality or quality group name to which cutoff.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("cutoff")),
+				},
+			},
 			"cutoff_format_score": schema.Int64Attribute{
 				MarkdownDescription: "Cutoff format score.",
 				Optional:            true,
@@ -149,20 +188,40 @@ func (r *QualityProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Optional:            true,
 				Computed:            true,
 			},
+			"default_format_score": schema.Int64Attribute{
+				MarkdownDescription: "Score assigned to all the custom formats not listed in `format_items`. Those formats are not stored in `format_items` while they keep this score. Defaults to `0`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 			"language": schema.SingleNestedAttribute{
 				MarkdownDescription: "Language.",
 				Required:            true,
 				Attributes:          r.getQualityLanguageSchema().Attributes,
 			},
 			"quality_groups": schema.SetNestedAttribute{
-				MarkdownDescription: "Quality groups.",
-				Required:            true,
+				MarkdownDescription: "Quality groups, all allowed. Prefer `qualities`, which keeps the preference order.",
+				Optional:            true,
+				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: r.getQualityGroupSchema().Attributes,
 				},
 			},
+			"qualities": schema.ListNestedAttribute{
+				MarkdownDescription: "Qualities and quality groups by name, from the least to the most preferred. Group IDs are assigned from `1000` following the list order.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.List{
+					listvalidator.ExactlyOneOf(path.MatchRoot("quality_groups")),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: r.getQualityItemSchema().Attributes,
+				},
+			},
 			"format_items": schema.SetNestedAttribute{
-				MarkdownDescription: "Format items.",
+				MarkdownDescription: "Format items. Each item needs either `format` or `name`.",
 				Optional:            true,
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
@@ -197,6 +256,27 @@ func (r QualityProfileResource) getQualityGroupSchema() schema.Schema {
 	}
 }
 
+func (r QualityProfileResource) getQualityItemSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Quality name, or quality group name if `members` is set.",
+				Required:            true,
+			},
+			"allowed": schema.BoolAttribute{
+				MarkdownDescription: "Allowed flag. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"members": schema.ListAttribute{
+				MarkdownDescription: "Quality names in group.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
 func (r QualityProfileResource) getQualitySchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
@@ -244,6 +324,9 @@ func (r QualityProfileResource) getFormatItemsSchema() schema.Schema {
 				MarkdownDescription: "Format.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("name")),
+				},
 			},
 			"score": schema.Int64Attribute{
 				MarkdownDescription: "Score.",
@@ -251,7 +334,7 @@ func (r QualityProfileResource) getFormatItemsSchema() schema.Schema {
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Name.",
+				MarkdownDescription: "Custom format name.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -275,6 +358,12 @@ func (r QualityProfileResource) getQualityLanguageSchema() schema.Schema {
 	}
 }
 
+func (r *QualityProfileResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *QualityProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -292,19 +381,24 @@ func (r *QualityProfileResource) Create(ctx context.Context, req resource.Create
 	}
 
 	// Build Create resource
-	request := profile.read(ctx, &resp.Diagnostics)
+	request := r.readProfile(ctx, profile, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Create new QualityProfile
 	response, _, err := r.client.QualityProfileApi.CreateQualityProfile(ctx).QualityProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, qualityProfileResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Create, qualityProfileResourceName, err)...)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+qualityProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	formats := profile.FormatItems
 	profile.write(ctx, response, &resp.Diagnostics)
+	profile.hideDefaultFormats(ctx, formats, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
 }
 
@@ -319,16 +413,20 @@ func (r *QualityProfileResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get qualityprofile current value
-	response, _, err := r.client.QualityProfileApi.GetQualityProfileById(ctx, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.QualityProfileApi.GetQualityProfileById(ctx, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityProfileResourceName, err))
+		if !helpers.RemoveNotFound(ctx, qualityProfileResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, qualityProfileResourceName, err)...)
+		}
 
 		return
 	}
 
 	tflog.Trace(ctx, "read "+qualityProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	formats := profile.FormatItems
 	profile.write(ctx, response, &resp.Diagnostics)
+	profile.hideDefaultFormats(ctx, formats, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
 }
 
@@ -343,19 +441,24 @@ func (r *QualityProfileResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Build Update resource
-	request := profile.read(ctx, &resp.Diagnostics)
+	request := r.readProfile(ctx, profile, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Update QualityProfile
 	response, _, err := r.client.QualityProfileApi.UpdateQualityProfile(ctx, strconv.Itoa(int(request.GetId()))).QualityProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, qualityProfileResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Update, qualityProfileResourceName, err)...)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+qualityProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	formats := profile.FormatItems
 	profile.write(ctx, response, &resp.Diagnostics)
+	profile.hideDefaultFormats(ctx, formats, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
 }
 
@@ -371,7 +474,7 @@ func (r *QualityProfileResource) Delete(ctx context.Context, req resource.Delete
 	// Delete qualityprofile current value
 	_, err := r.client.QualityProfileApi.DeleteQualityProfile(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, qualityProfileResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Delete, qualityProfileResourceName, err)...)
 
 		return
 	}
@@ -385,6 +488,34 @@ func (r *QualityProfileResource) ImportState(ctx context.Context, req resource.I
 	tflog.Trace(ctx, "imported "+qualityProfileResourceName+": "+req.ID)
 }
 
+// readProfile builds the request, getting the quality definitions if qualities are referenced by name
+// and the custom formats to resolve format names and fill in the unlisted ones.
+func (r *QualityProfileResource) readProfile(ctx context.Context, profile *QualityProfile, diags *diag.Diagnostics) *whisparr.QualityProfileResource {
+	var definitions []*whisparr.QualityDefinitionResource
+
+	customFormats, _, err := r.client.CustomFormatApi.ListCustomFormat(ctx).Execute()
+	if err != nil {
+		diags.Append(helpers.ParseClientDiagnostics(helpers.Read, customFormatResourceName, err)...)
+
+		return nil
+	}
+
+	if profile.hasQualityNames() {
+		definitions, _, err = r.client.QualityDefinitionApi.ListQualityDefinition(ctx).Execute()
+		if err != nil {
+			diags.Append(helpers.ParseClientDiagnostics(helpers.Read, qualityDataSourceName, err)...)
+
+			return nil
+		}
+	}
+
+	return profile.read(ctx, definitions, customFormats, diags)
+}
+
+func (p *QualityProfile) hasQualityNames() bool {
+	return !p.Qualities.IsNull() && !p.Qualities.IsUnknown()
+}
+
 func (p *QualityProfile) write(ctx context.Context, profile *whisparr.QualityProfileResource, diags *diag.Diagnostics) {
 	var tempDiag diag.Diagnostics
 
@@ -396,8 +527,16 @@ func (p *QualityProfile) write(ctx context.Context, profile *whisparr.QualityPro
 	p.MinFormatScore = types.Int64Value(int64(profile.GetMinFormatScore()))
 
 	qualityGroups := make([]QualityGroup, len(profile.GetItems()))
+	qualityItems := make([]QualityItem, len(profile.GetItems()))
+	p.CutoffName = types.StringValue("")
+
 	for n, g := range profile.GetItems() {
 		qualityGroups[n].write(ctx, g, diags)
+		qualityItems[n].write(ctx, g, diags)
+
+		if qualityItemID(g) == profile.GetCutoff() {
+			p.CutoffName = qualityItems[n].Name
+		}
 	}
 
 	formatItems := make([]FormatItem, len(profile.GetFormatItems()))
@@ -412,6 +551,8 @@ func (p *QualityProfile) write(ctx context.Context, profile *whisparr.QualityPro
 	diags.Append(tempDiag...)
 	p.QualityGroups, tempDiag = types.SetValueFrom(ctx, QualityGroup{}.getType(), qualityGroups)
 	diags.Append(tempDiag...)
+	p.Qualities, tempDiag = types.ListValueFrom(ctx, QualityItem{}.getType(), qualityItems)
+	diags.Append(tempDiag...)
 	p.FormatItems, tempDiag = types.SetValueFrom(ctx, FormatItem{}.getType(), formatItems)
 	diags.Append(tempDiag...)
 }
@@ -444,6 +585,37 @@ func (q *QualityGroup) write(ctx context.Context, group *whisparr.QualityProfile
 	diags.Append(tempDiag...)
 }
 
+// qualityItemID returns the group ID, or the quality ID for single quality items.
+func qualityItemID(item *whisparr.QualityProfileQualityItemResource) int32 {
+	if len(item.GetItems()) == 0 {
+		return item.Quality.GetId()
+	}
+
+	return item.GetId()
+}
+
+func (q *QualityItem) write(ctx context.Context, item *whisparr.QualityProfileQualityItemResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	q.Allowed = types.BoolValue(item.GetAllowed())
+
+	if len(item.GetItems()) == 0 {
+		q.Name = types.StringValue(item.Quality.GetName())
+		q.Members = types.ListNull(types.StringType)
+
+		return
+	}
+
+	members := make([]string, len(item.GetItems()))
+	for m, i := range item.GetItems() {
+		members[m] = i.Quality.GetName()
+	}
+
+	q.Name = types.StringValue(item.GetName())
+	q.Members, tempDiag = types.ListValueFrom(ctx, types.StringType, members)
+	diags.Append(tempDiag...)
+}
+
 func (q *Quality) write(quality *whisparr.QualityProfileQualityItemResource) {
 	q.ID = types.Int64Value(int64(quality.Quality.GetId()))
 	q.Name = types.StringValue(quality.Quality.GetName())
@@ -462,7 +634,183 @@ func (l *QualityLanguage) write(language *whisparr.Language) {
 	l.ID = types.Int64Value(int64(language.GetId()))
 }
 
-func (p *QualityProfile) read(ctx context.Context, diags *diag.Diagnostics) *whisparr.QualityProfileResource {
+func (p *QualityProfile) read(ctx context.Context, definitions []*whisparr.QualityDefinitionResource, customFormats []*whisparr.CustomFormatResource, diags *diag.Diagnostics) *whisparr.QualityProfileResource {
+	var qualities []*whisparr.QualityProfileQualityItemResource
+	if p.hasQualityNames() {
+		qualities = p.readQualities(ctx, definitions, diags)
+	} else {
+		qualities = p.readQualityGroups(ctx, diags)
+	}
+
+	formatItems := p.readFormatItems(ctx, customFormats, diags)
+
+	language := QualityLanguage{}
+	p.Language.As(ctx, &language, basetypes.ObjectAsOptions{})
+
+	profile := whisparr.NewQualityProfileResource()
+	profile.SetUpgradeAllowed(p.UpgradeAllowed.ValueBool())
+	profile.SetId(int32(p.ID.ValueInt64()))
+	profile.SetCutoff(int32(p.Cutoff.ValueInt64()))
+	profile.SetMinFormatScore(int32(p.MinFormatScore.ValueInt64()))
+	profile.SetCutoffFormatScore(int32(p.CutoffFormatScore.ValueInt64()))
+	profile.SetName(p.Name.ValueString())
+	profile.SetLanguage(*language.read())
+	profile.SetItems(qualities)
+	profile.SetFormatItems(formatItems)
+
+	if !p.CutoffName.IsNull() && !p.CutoffName.IsUnknown() {
+		p.readCutoffName(profile, diags)
+	}
+
+	return profile
+}
+
+// readFormatItems resolves format names and scores the unlisted formats with the default score.
+func (p *QualityProfile) readFormatItems(ctx context.Context, customFormats []*whisparr.CustomFormatResource, diags *diag.Diagnostics) []*whisparr.ProfileFormatItemResource {
+	formatIDs := make(map[string]int32, len(customFormats))
+	for _, c := range customFormats {
+		formatIDs[c.GetName()] = c.GetId()
+	}
+
+	formats := make([]FormatItem, len(p.FormatItems.Elements()))
+	diags.Append(p.FormatItems.ElementsAs(ctx, &formats, true)...)
+
+	listed := make(map[int32]bool, len(formats))
+	formatItems := make([]*whisparr.ProfileFormatItemResource, len(formats))
+
+	for n, f := range formats {
+		if f.Format.IsNull() || f.Format.IsUnknown() {
+			id, ok := formatIDs[f.Name.ValueString()]
+			if !ok {
+				diags.AddError(helpers.ResourceError, helpers.ParseNotFoundError(customFormatResourceName, "name", f.Name.ValueString()))
+			}
+
+			f.Format = types.Int64Value(int64(id))
+		}
+
+		formatItems[n] = f.read()
+		listed[formatItems[n].GetFormat()] = true
+	}
+
+	for _, c := range customFormats {
+		if listed[c.GetId()] {
+			continue
+		}
+
+		item := whisparr.NewProfileFormatItemResource()
+		item.SetFormat(c.GetId())
+		item.SetName(c.GetName())
+		item.SetScore(int32(p.DefaultFormatScore.ValueInt64()))
+		formatItems = append(formatItems, item)
+	}
+
+	return formatItems
+}
+
+// hideDefaultFormats removes from the format items the ones not listed in the reference set which have the default score.
+func (p *QualityProfile) hideDefaultFormats(ctx context.Context, reference types.Set, diags *diag.Diagnostics) {
+	if p.DefaultFormatScore.IsNull() || p.DefaultFormatScore.IsUnknown() {
+		p.DefaultFormatScore = types.Int64Value(0)
+	}
+
+	var listed, formats []FormatItem
+
+	if !reference.IsNull() && !reference.IsUnknown() {
+		diags.Append(reference.ElementsAs(ctx, &listed, true)...)
+	}
+
+	diags.Append(p.FormatItems.ElementsAs(ctx, &formats, true)...)
+
+	names := make(map[string]bool, len(listed))
+	ids := make(map[int64]bool, len(listed))
+
+	for _, f := range listed {
+		names[f.Name.ValueString()] = !f.Name.IsNull() && !f.Name.IsUnknown()
+		ids[f.Format.ValueInt64()] = !f.Format.IsNull() && !f.Format.IsUnknown()
+	}
+
+	visible := make([]FormatItem, 0, len(formats))
+
+	for _, f := range formats {
+		if names[f.Name.ValueString()] || ids[f.Format.ValueInt64()] || f.Score.ValueInt64() != p.DefaultFormatScore.ValueInt64() {
+			visible = append(visible, f)
+		}
+	}
+
+	var tempDiag diag.Diagnostics
+
+	p.FormatItems, tempDiag = types.SetValueFrom(ctx, FormatItem{}.getType(), visible)
+	diags.Append(tempDiag...)
+}
+
+// readCutoffName sets the cutoff to the ID of the quality or group with the given name.
+func (p *QualityProfile) readCutoffName(profile *whisparr.QualityProfileResource, diags *diag.Diagnostics) {
+	name := p.CutoffName.ValueString()
+
+	for _, i := range profile.GetItems() {
+		if (len(i.GetItems()) == 0 && i.Quality.GetName() == name) || (len(i.GetItems()) != 0 && i.GetName() == name) {
+			profile.SetCutoff(qualityItemID(i))
+
+			return
+		}
+	}
+
+	diags.AddError(helpers.ResourceError, helpers.ParseNotFoundError(qualityProfileResourceName+" quality", "name", name))
+}
+
+// readQualities builds the ordered profile items from quality names.
+func (p *QualityProfile) readQualities(ctx context.Context, definitions []*whisparr.QualityDefinitionResource, diags *diag.Diagnostics) []*whisparr.QualityProfileQualityItemResource {
+	qualityByName := make(map[string]whisparr.Quality, len(definitions))
+	for _, d := range definitions {
+		qualityByName[d.Quality.GetName()] = d.GetQuality()
+	}
+
+	lookup := func(name string) *whisparr.Quality {
+		quality, ok := qualityByName[name]
+		if !ok {
+			diags.AddError(helpers.ResourceError, helpers.ParseNotFoundError(qualityDataSourceName, "name", name))
+		}
+
+		return &quality
+	}
+
+	items := make([]QualityItem, len(p.Qualities.Elements()))
+	diags.Append(p.Qualities.ElementsAs(ctx, &items, false)...)
+	qualities := make([]*whisparr.QualityProfileQualityItemResource, len(items))
+
+	for n, i := range items {
+		allowed := i.Allowed.IsNull() || i.Allowed.IsUnknown() || i.Allowed.ValueBool()
+		quality := whisparr.NewQualityProfileQualityItemResource()
+		quality.SetAllowed(allowed)
+		qualities[n] = quality
+
+		var members []string
+		if !i.Members.IsNull() && !i.Members.IsUnknown() {
+			diags.Append(i.Members.ElementsAs(ctx, &members, false)...)
+		}
+
+		if len(members) == 0 {
+			quality.SetQuality(*lookup(i.Name.ValueString()))
+
+			continue
+		}
+
+		groupItems := make([]*whisparr.QualityProfileQualityItemResource, len(members))
+		for m, name := range members {
+			groupItems[m] = whisparr.NewQualityProfileQualityItemResource()
+			groupItems[m].SetAllowed(allowed)
+			groupItems[m].SetQuality(*lookup(name))
+		}
+
+		quality.SetId(int32(qualityGroupStartID + n))
+		quality.SetName(i.Name.ValueString())
+		quality.SetItems(groupItems)
+	}
+
+	return qualities
+}
+
+func (p *QualityProfile) readQualityGroups(ctx context.Context, diags *diag.Diagnostics) []*whisparr.QualityProfileQualityItemResource {
 	groups := make([]QualityGroup, len(p.QualityGroups.Elements()))
 	diags.Append(p.QualityGroups.ElementsAs(ctx, &groups, false)...)
 	qualities := make([]*whisparr.QualityProfileQualityItemResource, len(groups))
@@ -500,29 +848,7 @@ func (p *QualityProfile) read(ctx context.Context, diags *diag.Diagnostics) *whi
 		qualities[n] = quality
 	}
 
-	formats := make([]FormatItem, len(p.FormatItems.Elements()))
-	diags.Append(p.FormatItems.ElementsAs(ctx, &formats, true)...)
-
-	formatItems := make([]*whisparr.ProfileFormatItemResource, len(formats))
-	for n, f := range formats {
-		formatItems[n] = f.read()
-	}
-
-	language := QualityLanguage{}
-	p.Language.As(ctx, &language, basetypes.ObjectAsOptions{})
-
-	profile := whisparr.NewQualityProfileResource()
-	profile.SetUpgradeAllowed(p.UpgradeAllowed.ValueBool())
-	profile.SetId(int32(p.ID.ValueInt64()))
-	profile.SetCutoff(int32(p.Cutoff.ValueInt64()))
-	profile.SetMinFormatScore(int32(p.MinFormatScore.ValueInt64()))
-	profile.SetCutoffFormatScore(int32(p.CutoffFormatScore.ValueInt64()))
-	profile.SetName(p.Name.ValueString())
-	profile.SetLanguage(*language.read())
-	profile.SetItems(qualities)
-	profile.SetFormatItems(formatItems)
-
-	return profile
+	return qualities
 }
 
 func (q *Quality) read() *whisparr.QualityProfileQualityItemResource {
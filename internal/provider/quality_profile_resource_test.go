@@ -1,13 +1,70 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestQualityProfileHideDefaultFormats(t *testing.T) {
+	t.Parallel()
+
+	formats := []FormatItem{
+		{Name: types.StringValue("listed"), Format: types.Int64Value(1), Score: types.Int64Value(0)},
+		{Name: types.StringValue("zero"), Format: types.Int64Value(2), Score: types.Int64Value(0)},
+		{Name: types.StringValue("ten"), Format: types.Int64Value(3), Score: types.Int64Value(10)},
+	}
+
+	tests := map[string]struct {
+		expected []string
+		score    types.Int64
+	}{
+		"not set": {
+			score:    types.Int64Null(),
+			expected: []string{"listed", "ten"},
+		},
+		"set": {
+			score:    types.Int64Value(10),
+			expected: []string{"listed", "zero"},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			ctx := context.Background()
+			reference, _ := types.SetValueFrom(ctx, FormatItem{}.getType(), formats[:1])
+			profile := QualityProfile{DefaultFormatScore: test.score}
+			profile.FormatItems, _ = types.SetValueFrom(ctx, FormatItem{}.getType(), formats)
+
+			profile.hideDefaultFormats(ctx, reference, &diags)
+
+			var visible []FormatItem
+
+			diags.Append(profile.FormatItems.ElementsAs(ctx, &visible, false)...)
+			assert.False(t, diags.HasError())
+			assert.False(t, profile.DefaultFormatScore.IsNull())
+
+			names := make([]string, len(visible))
+			for i, f := range visible {
+				names[i] = f.Name.ValueString()
+			}
+
+			assert.ElementsMatch(t, test.expected, names)
+		})
+	}
+}
+
 func TestAccQualityProfileResource(t *testing.T) {
 	// no parallel to avoid conflict with custom formats
 	resource.Test(t, resource.TestCase{
@@ -45,6 +102,24 @@ func TestAccQualityProfileResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Update with qualities by name
+			{
+				Config: testAccQualityProfileResourceQualitiesConfig("example-HD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("whisparr_quality_profile.test", "cutoff_name", "WEB 2160p"),
+					resource.TestCheckResourceAttr("whisparr_quality_profile.test", "qualities.0.name", "HDTV-2160p"),
+					resource.TestCheckResourceAttr("whisparr_quality_profile.test", "qualities.0.allowed", "false"),
+					resource.TestCheckResourceAttr("whisparr_quality_profile.test", "qualities.1.members.1", "WEBRip-2160p"),
+				),
+			},
+			// Update with format items by name
+			{
+				Config: testAccQualityProfileResourceFormatNamesConfig("example-HD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("whisparr_quality_profile.test", "format_items.#", "1"),
+					resource.TestCheckResourceAttrPair("whisparr_quality_profile.test", "format_items.0.format", "whisparr_custom_format.test", "id"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -145,3 +220,76 @@ func testAccQualityProfileResourceConfig(name string) string {
 		]
 	}`, name)
 }
+
+func testAccQualityProfileResourceQualitiesConfig(name string) string {
+	return fmt.Sprintf(`
+	data "whisparr_language" "test" {
+		name = "English"
+	}
+
+	resource "whisparr_quality_profile" "test" {
+		name            = "%s"
+		upgrade_allowed = true
+		cutoff_name     = "WEB 2160p"
+
+		language = data.whisparr_language.test
+
+		qualities = [
+			{
+				name    = "HDTV-2160p"
+				allowed = false
+			},
+			{
+				name    = "WEB 2160p"
+				members = ["WEBDL-2160p", "WEBRip-2160p"]
+			},
+			{
+				name = "Bluray-2160p"
+			}
+		]
+	}`, name)
+}
+
+func testAccQualityProfileResourceFormatNamesConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "whisparr_custom_format" "test" {
+		include_custom_format_when_renaming = false
+		name = "QualityFormatTest"
+
+		specifications = [
+			{
+				name = "Surround Sound"
+				implementation = "ReleaseTitleSpecification"
+				negate = false
+				required = false
+				value = "DTS.?(HD|ES|X(?!\\D))|TRUEHD|ATMOS|DD(\\+|P).?([5-9])|EAC3.?([5-9])"
+			}
+		]
+	}
+
+	data "whisparr_language" "test" {
+		name = "English"
+	}
+
+	resource "whisparr_quality_profile" "test" {
+		name                 = "%s"
+		upgrade_allowed      = true
+		cutoff_name          = "Bluray-2160p"
+		default_format_score = 0
+
+		language = data.whisparr_language.test
+
+		qualities = [
+			{
+				name = "Bluray-2160p"
+			}
+		]
+
+		format_items = [
+			{
+				name  = whisparr_custom_format.test.name
+				score = 10
+			}
+		]
+	}`, name)
+}
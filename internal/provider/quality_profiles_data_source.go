@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,7 +22,7 @@ func NewQualityProfilesDataSource() datasource.DataSource {
 
 // QualityProfilesDataSource defines the qyality profiles implementation.
 type QualityProfilesDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // QualityProfiles describes the qyality profiles data model.
@@ -74,6 +73,39 @@ func (d *QualityProfilesDataSource) Schema(_ context.Context, _ datasource.Schem
 							MarkdownDescription: "Min format score.",
 							Computed:            true,
 						},
+						"default_format_score": schema.Int64Attribute{
+							MarkdownDescription: "Default format score, only set on the resource.",
+							Computed:            true,
+						},
+						"cutoff_name": schema.StringAttribute{
+							MarkdownDescription: "Quality or quality group name to which cutoff.",
+							Computed:            true,
+						},
+						"qualities": schema.ListNestedAttribute{
+							MarkdownDescription: "Qualities and quality groups by name, from the least to the most preferred.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										MarkdownDescription: "Quality name, or quality group name if `members` is set.",
+										Computed:            true,
+									},
+									"allowed": schema.BoolAttribute{
+										MarkdownDescription: "Allowed flag.",
+										Computed:            true,
+									},
+									"members": schema.ListAttribute{
+										MarkdownDescription: "Quality names in group.",
+										Computed:            true,
+										ElementType:         types.StringType,
+									},
+								},
+							},
+						},
+						"json": schema.StringAttribute{
+							MarkdownDescription: "Quality Profile JSON export, same format as the Whisparr UI.",
+							Computed:            true,
+						},
 						"language": schema.SingleNestedAttribute{
 							MarkdownDescription: "Language.",
 							Computed:            true,
@@ -165,19 +197,19 @@ func (d *QualityProfilesDataSource) Read(ctx context.Context, _ datasource.ReadR
 	// Get qualityprofiles current value
 	response, _, err := d.client.QualityProfileApi.ListQualityProfile(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, qualityProfilesDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.List, qualityProfilesDataSourceName, err)...)
 
 		return
 	}
 
 	tflog.Trace(ctx, "read "+qualityProfilesDataSourceName)
 	// Map response body to resource schema attribute
-	profiles := make([]QualityProfile, len(response))
+	profiles := make([]ExportedQualityProfile, len(response))
 	for i, p := range response {
 		profiles[i].write(ctx, p, &resp.Diagnostics)
 	}
 
-	profileList, diags := types.SetValueFrom(ctx, QualityProfile{}.getType(), profiles)
+	profileList, diags := types.SetValueFrom(ctx, ExportedQualityProfile{}.getType(), profiles)
 	resp.Diagnostics.Append(diags...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, QualityProfiles{QualityProfiles: profileList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
 }
@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
+	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const releaseScoreDataSourceName = "release_score"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ReleaseScoreDataSource{}
+
+func NewReleaseScoreDataSource() datasource.DataSource {
+	return &ReleaseScoreDataSource{}
+}
+
+// ReleaseScoreDataSource defines the release score implementation.
+type ReleaseScoreDataSource struct {
+	client *helpers.Client
+}
+
+// ReleaseScore describes the release score data model.
+type ReleaseScore struct {
+	Titles            types.List   `tfsdk:"titles"`
+	Releases          types.List   `tfsdk:"releases"`
+	ID                types.String `tfsdk:"id"`
+	QualityProfileID  types.Int64  `tfsdk:"quality_profile_id"`
+	MinFormatScore    types.Int64  `tfsdk:"min_format_score"`
+	CutoffFormatScore types.Int64  `tfsdk:"cutoff_format_score"`
+}
+
+// ReleaseScoreItem is part of ReleaseScore.
+type ReleaseScoreItem struct {
+	CustomFormats     types.Set    `tfsdk:"custom_formats"`
+	Title             types.String `tfsdk:"title"`
+	QualityName       types.String `tfsdk:"quality_name"`
+	CustomFormatScore types.Int64  `tfsdk:"custom_format_score"`
+	QualityID         types.Int64  `tfsdk:"quality_id"`
+	QualityRank       types.Int64  `tfsdk:"quality_rank"`
+	QualityAllowed    types.Bool   `tfsdk:"quality_allowed"`
+	Accepted          types.Bool   `tfsdk:"accepted"`
+	MeetsCutoff       types.Bool   `tfsdk:"meets_cutoff"`
+}
+
+func (r ReleaseScoreItem) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"custom_formats":      types.SetType{}.WithElementType(ParseCustomFormat{}.getType()),
+			"title":               types.StringType,
+			"quality_name":        types.StringType,
+			"custom_format_score": types.Int64Type,
+			"quality_id":          types.Int64Type,
+			"quality_rank":        types.Int64Type,
+			"quality_allowed":     types.BoolType,
+			"accepted":            types.BoolType,
+			"meets_cutoff":        types.BoolType,
+		})
+}
+
+func (d *ReleaseScoreDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + releaseScoreDataSourceName
+}
+
+func (d *ReleaseScoreDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Profiles -->Score release titles against a [Quality Profile](../resources/quality_profile).\n" +
+			"Each title is [parsed](parse) and its custom formats are scored with the profile format items.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Quality profile ID.",
+				Required:            true,
+			},
+			"titles": schema.ListAttribute{
+				MarkdownDescription: "Release titles.",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"min_format_score": schema.Int64Attribute{
+				MarkdownDescription: "Quality profile min format score.",
+				Computed:            true,
+			},
+			"cutoff_format_score": schema.Int64Attribute{
+				MarkdownDescription: "Quality profile cutoff format score.",
+				Computed:            true,
+			},
+			"releases": schema.ListNestedAttribute{
+				MarkdownDescription: "Scored releases, in the same order as `titles`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Release title.",
+							Computed:            true,
+						},
+						"quality_id": schema.Int64Attribute{
+							MarkdownDescription: "Parsed quality ID.",
+							Computed:            true,
+						},
+						"quality_name": schema.StringAttribute{
+							MarkdownDescription: "Parsed quality name.",
+							Computed:            true,
+						},
+						"quality_rank": schema.Int64Attribute{
+							MarkdownDescription: "Rank of the quality in the profile quality groups, higher is better. `0` if not found.",
+							Computed:            true,
+						},
+						"quality_allowed": schema.BoolAttribute{
+							MarkdownDescription: "Quality allowed flag.",
+							Computed:            true,
+						},
+						"custom_format_score": schema.Int64Attribute{
+							MarkdownDescription: "Total custom format score.",
+							Computed:            true,
+						},
+						"accepted": schema.BoolAttribute{
+							MarkdownDescription: "True if the quality is allowed and the score reaches `min_format_score`.",
+							Computed:            true,
+						},
+						"meets_cutoff": schema.BoolAttribute{
+							MarkdownDescription: "True if both the quality cutoff and `cutoff_format_score` are reached.",
+							Computed:            true,
+						},
+						"custom_formats": schema.SetNestedAttribute{
+							MarkdownDescription: "Matching custom formats.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: ParseDataSource{}.getCustomFormatSchema().Attributes,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ReleaseScoreDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *ReleaseScoreDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *ReleaseScore
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get quality profile current value
+	profile, _, err := d.client.QualityProfileApi.GetQualityProfileById(ctx, int32(data.QualityProfileID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, qualityProfileResourceName, err)...)
+
+		return
+	}
+
+	var titles []string
+
+	resp.Diagnostics.Append(data.Titles.ElementsAs(ctx, &titles, false)...)
+
+	// Parse and score each title
+	scores := formatScores(profile)
+	_, cutoffRank := qualityRank(profile, profile.GetCutoff())
+	releases := make([]ReleaseScoreItem, len(titles))
+
+	for i, t := range titles {
+		result := parseTitle(ctx, d.client, t, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		releases[i].write(ctx, t, result, profile, scores, cutoffRank, &resp.Diagnostics)
+	}
+
+	tflog.Trace(ctx, "read "+releaseScoreDataSourceName)
+	// Map response body to resource schema attribute
+	var tempDiag diag.Diagnostics
+
+	data.Releases, tempDiag = types.ListValueFrom(ctx, ReleaseScoreItem{}.getType(), releases)
+	resp.Diagnostics.Append(tempDiag...)
+
+	data.MinFormatScore = types.Int64Value(int64(profile.GetMinFormatScore()))
+	data.CutoffFormatScore = types.Int64Value(int64(profile.GetCutoffFormatScore()))
+	data.ID = types.StringValue(strconv.Itoa(len(releases)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// qualityRank returns the allowed flag and the 1-based position of the item containing the given quality or group ID.
+func qualityRank(profile *whisparr.QualityProfileResource, id int32) (bool, int) {
+	for i, item := range profile.GetItems() {
+		if item.Quality != nil && item.Quality.GetId() == id {
+			return item.GetAllowed(), i + 1
+		}
+
+		// Only groups have their own ID, quality items have an empty list of items.
+		if len(item.GetItems()) == 0 {
+			continue
+		}
+
+		if item.GetId() == id {
+			return item.GetAllowed(), i + 1
+		}
+
+		for _, q := range item.GetItems() {
+			if q.Quality.GetId() == id {
+				return item.GetAllowed(), i + 1
+			}
+		}
+	}
+
+	return false, 0
+}
+
+func (r *ReleaseScoreItem) write(ctx context.Context, title string, result *parseResult, profile *whisparr.QualityProfileResource, scores map[int32]int32, cutoffRank int, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	info := result.GetParsedMovieInfo()
+	quality := info.GetQuality()
+	allowed, rank := qualityRank(profile, quality.Quality.GetId())
+
+	var score int32
+
+	formats := make([]ParseCustomFormat, len(result.CustomFormats))
+	for i, f := range result.CustomFormats {
+		formats[i].write(f, scores)
+		score += scores[f.GetId()]
+	}
+
+	r.Title = types.StringValue(title)
+	r.QualityID = types.Int64Value(int64(quality.Quality.GetId()))
+	r.QualityName = types.StringValue(quality.Quality.GetName())
+	r.QualityRank = types.Int64Value(int64(rank))
+	r.QualityAllowed = types.BoolValue(allowed)
+	r.CustomFormatScore = types.Int64Value(int64(score))
+	r.Accepted = types.BoolValue(allowed && score >= profile.GetMinFormatScore())
+	r.MeetsCutoff = types.BoolValue(rank != 0 && rank >= cutoffRank && score >= profile.GetCutoffFormatScore())
+	r.CustomFormats, tempDiag = types.SetValueFrom(ctx, ParseCustomFormat{}.getType(), formats)
+	diags.Append(tempDiag...)
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQualityRank(t *testing.T) {
+	t.Parallel()
+
+	quality := func(id int32) *whisparr.QualityProfileQualityItemResource {
+		item := whisparr.NewQualityProfileQualityItemResource()
+		item.SetQuality(whisparr.Quality{Id: &id})
+		item.SetItems([]*whisparr.QualityProfileQualityItemResource{})
+		item.SetAllowed(true)
+
+		return item
+	}
+
+	group := whisparr.NewQualityProfileQualityItemResource()
+	group.SetId(1001)
+	group.SetItems([]*whisparr.QualityProfileQualityItemResource{quality(3), quality(4)})
+
+	// quality items carry an ID of 0, which must not match the groups
+	profile := whisparr.NewQualityProfileResource()
+	profile.SetItems([]*whisparr.QualityProfileQualityItemResource{quality(1), group, quality(2)})
+
+	tests := map[string]struct {
+		rank    int
+		id      int32
+		allowed bool
+	}{
+		"quality": {
+			id:      2,
+			rank:    3,
+			allowed: true,
+		},
+		"grouped quality": {
+			id:   4,
+			rank: 2,
+		},
+		"group": {
+			id:   1001,
+			rank: 2,
+		},
+		"quality item id": {
+			id: 0,
+		},
+		"missing": {
+			id: 5,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			allowed, rank := qualityRank(profile, test.id)
+			assert.Equal(t, test.allowed, allowed)
+			assert.Equal(t, test.rank, rank)
+		})
+	}
+}
+
+func TestAccReleaseScoreDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccReleaseScoreDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccReleaseScoreDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.whisparr_release_score.test", "releases.#", "2"),
+					resource.TestCheckResourceAttr("data.whisparr_release_score.test", "releases.0.quality_name", "Bluray-1080p"),
+					resource.TestCheckResourceAttr("data.whisparr_release_score.test", "releases.1.quality_name", "DVD"),
+					resource.TestCheckResourceAttrSet("data.whisparr_release_score.test", "releases.0.quality_rank"),
+					resource.TestCheckResourceAttrSet("data.whisparr_release_score.test", "min_format_score"),
+				),
+			},
+		},
+	})
+}
+
+const testAccReleaseScoreDataSourceConfig = `
+data "whisparr_release_score" "test" {
+	quality_profile_id = 1
+	titles = [
+		"Deep.Throat.1972.1080p.BluRay.x264-GROUP",
+		"Deep.Throat.1972.DVDRip.XviD-GROUP",
+	]
+}
+`
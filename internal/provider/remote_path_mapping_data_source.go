@@ -23,7 +23,7 @@ func NewRemotePathMappingDataSource() datasource.DataSource {
 
 // RemotePathMappingDataSource defines the remote path mapping implementation.
 type RemotePathMappingDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *RemotePathMappingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -72,7 +72,7 @@ func (d *RemotePathMappingDataSource) Read(ctx context.Context, req datasource.R
 	// Get remote path mapping current value
 	response, _, err := d.client.RemotePathMappingApi.ListRemotePathMapping(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, remotePathMappingDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, remotePathMappingDataSourceName, err)...)
 
 		return
 	}
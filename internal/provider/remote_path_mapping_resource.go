@@ -7,6 +7,7 @@ import (
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,8 +21,9 @@ const remotePathMappingResourceName = "remote_path_mapping"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &RemotePathMappingResource{}
-	_ resource.ResourceWithImportState = &RemotePathMappingResource{}
+	_ resource.Resource                 = &RemotePathMappingResource{}
+	_ resource.ResourceWithImportState  = &RemotePathMappingResource{}
+	_ resource.ResourceWithUpgradeState = &RemotePathMappingResource{}
 )
 
 func NewRemotePathMappingResource() resource.Resource {
@@ -30,7 +32,7 @@ func NewRemotePathMappingResource() resource.Resource {
 
 // RemotePathMappingResource defines the remote path mapping implementation.
 type RemotePathMappingResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // RemotePathMapping describes the remote path mapping data model.
@@ -41,6 +43,15 @@ type RemotePathMapping struct {
 	ID         types.Int64  `tfsdk:"id"`
 }
 
+// ManagedRemotePathMapping describes the remote path mapping resource data model.
+type ManagedRemotePathMapping struct {
+	Host          types.String `tfsdk:"host"`
+	RemotePath    types.String `tfsdk:"remote_path"`
+	LocalPath     types.String `tfsdk:"local_path"`
+	ID            types.Int64  `tfsdk:"id"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
+}
+
 func (r RemotePathMapping) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -51,10 +62,31 @@ func (r RemotePathMapping) getType() attr.Type {
 		})
 }
 
+func (r ManagedRemotePathMapping) toRemotePathMapping() *RemotePathMapping {
+	return &RemotePathMapping{
+		Host:       r.Host,
+		RemotePath: r.RemotePath,
+		LocalPath:  r.LocalPath,
+		ID:         r.ID,
+	}
+}
+
+func (r *ManagedRemotePathMapping) fromRemotePathMapping(mapping *RemotePathMapping) {
+	r.Host = mapping.Host
+	r.RemotePath = mapping.RemotePath
+	r.LocalPath = mapping.LocalPath
+	r.ID = mapping.ID
+}
+
 func (r *RemotePathMappingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->Remote Path Mapping resource.\nFor more information refer to [Remote Path Mapping](https://wiki.servarr.com/whisparr/settings#remote-path-mappings) documentation.",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Adopt an existing remote path mapping with the same host and remote path instead of failing on create. Defaults to the provider `adopt_existing` value.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Remote Path Mapping ID.",
 				Computed:            true,
@@ -82,6 +114,12 @@ func (r *RemotePathMappingResource) Metadata(_ context.Context, req resource.Met
 	resp.TypeName = req.ProviderTypeName + "_" + remotePathMappingResourceName
 }
 
+func (r *RemotePathMappingResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *RemotePathMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -90,20 +128,39 @@ func (r *RemotePathMappingResource) Configure(ctx context.Context, req resource.
 
 func (r *RemotePathMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var mapping *RemotePathMapping
+	var plan *ManagedRemotePathMapping
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &mapping)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	mapping := plan.toRemotePathMapping()
+
 	// Create new RemotePathMapping
 	request := mapping.read()
 
-	response, _, err := r.client.RemotePathMappingApi.CreateRemotePathMapping(ctx).RemotePathMappingResource(*request).Execute()
+	var (
+		response *whisparr.RemotePathMappingResource
+		err      error
+	)
+
+	// Adopt existing RemotePathMapping
+	existing := r.find(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if existing != nil {
+		request.SetId(existing.GetId())
+		response, _, err = r.client.RemotePathMappingApi.UpdateRemotePathMapping(ctx, strconv.Itoa(int(request.GetId()))).RemotePathMappingResource(*request).Execute()
+	} else {
+		response, _, err = r.client.RemotePathMappingApi.CreateRemotePathMapping(ctx).RemotePathMappingResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, remotePathMappingResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Create, remotePathMappingResourceName, err)...)
 
 		return
 	}
@@ -111,23 +168,28 @@ func (r *RemotePathMappingResource) Create(ctx context.Context, req resource.Cre
 	tflog.Trace(ctx, "created "+remotePathMappingResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	mapping.write(response)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &mapping)...)
+	plan.fromRemotePathMapping(mapping)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *RemotePathMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var mapping *RemotePathMapping
+	var state *ManagedRemotePathMapping
 
-	resp.Diagnostics.Append(req.State.Get(ctx, &mapping)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	mapping := state.toRemotePathMapping()
+
 	// Get remotePathMapping current value
-	response, _, err := r.client.RemotePathMappingApi.GetRemotePathMappingById(ctx, int32(mapping.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.RemotePathMappingApi.GetRemotePathMappingById(ctx, int32(mapping.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, remotePathMappingResourceName, err))
+		if !helpers.RemoveNotFound(ctx, remotePathMappingResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, remotePathMappingResourceName, err)...)
+		}
 
 		return
 	}
@@ -135,25 +197,28 @@ func (r *RemotePathMappingResource) Read(ctx context.Context, req resource.ReadR
 	tflog.Trace(ctx, "read "+remotePathMappingResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	mapping.write(response)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &mapping)...)
+	state.fromRemotePathMapping(mapping)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *RemotePathMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var mapping *RemotePathMapping
+	var plan *ManagedRemotePathMapping
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &mapping)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	mapping := plan.toRemotePathMapping()
+
 	// Update RemotePathMapping
 	request := mapping.read()
 
 	response, _, err := r.client.RemotePathMappingApi.UpdateRemotePathMapping(ctx, strconv.Itoa(int(request.GetId()))).RemotePathMappingResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, remotePathMappingResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Update, remotePathMappingResourceName, err)...)
 
 		return
 	}
@@ -161,7 +226,8 @@ func (r *RemotePathMappingResource) Update(ctx context.Context, req resource.Upd
 	tflog.Trace(ctx, "updated "+remotePathMappingResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	mapping.write(response)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &mapping)...)
+	plan.fromRemotePathMapping(mapping)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *RemotePathMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -176,7 +242,7 @@ func (r *RemotePathMappingResource) Delete(ctx context.Context, req resource.Del
 	// Delete remotePathMapping current value
 	_, err := r.client.RemotePathMappingApi.DeleteRemotePathMapping(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, remotePathMappingResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Delete, remotePathMappingResourceName, err)...)
 
 		return
 	}
@@ -190,6 +256,28 @@ func (r *RemotePathMappingResource) ImportState(ctx context.Context, req resourc
 	tflog.Trace(ctx, "imported "+remotePathMappingResourceName+": "+req.ID)
 }
 
+// find returns the remote path mapping with the same host and remote path, if it has to be adopted.
+func (r *RemotePathMappingResource) find(ctx context.Context, mapping *ManagedRemotePathMapping, diags *diag.Diagnostics) *whisparr.RemotePathMappingResource {
+	if !r.client.Adopt(mapping.AdoptExisting) {
+		return nil
+	}
+
+	mappings, _, err := r.client.RemotePathMappingApi.ListRemotePathMapping(ctx).Execute()
+	if err != nil {
+		diags.Append(helpers.ParseClientDiagnostics(helpers.List, remotePathMappingResourceName, err)...)
+
+		return nil
+	}
+
+	for _, m := range mappings {
+		if m.GetHost() == mapping.Host.ValueString() && m.GetRemotePath() == mapping.RemotePath.ValueString() {
+			return m
+		}
+	}
+
+	return nil
+}
+
 func (r *RemotePathMapping) write(remotePathMapping *whisparr.RemotePathMappingResource) {
 	r.ID = types.Int64Value(int64(remotePathMapping.GetId()))
 	r.Host = types.StringValue(remotePathMapping.GetHost())
@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,7 +22,7 @@ func NewRemotePathMappingsDataSource() datasource.DataSource {
 
 // RemotePathMappingsDataSource defines the remote path mappings implementation.
 type RemotePathMappingsDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // RemotePathMappings describes the remote path mappings data model.
@@ -83,7 +82,7 @@ func (d *RemotePathMappingsDataSource) Read(ctx context.Context, _ datasource.Re
 	// Get remotePathMappings current value
 	response, _, err := d.client.RemotePathMappingApi.ListRemotePathMapping(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, remotePathMappingsDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.List, remotePathMappingsDataSourceName, err)...)
 
 		return
 	}
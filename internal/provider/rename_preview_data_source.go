@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
+	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const renamePreviewDataSourceName = "rename_preview"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RenamePreviewDataSource{}
+
+func NewRenamePreviewDataSource() datasource.DataSource {
+	return &RenamePreviewDataSource{}
+}
+
+// RenamePreviewDataSource defines the rename preview implementation.
+type RenamePreviewDataSource struct {
+	client *helpers.Client
+}
+
+// RenamePreview describes the rename preview data model.
+type RenamePreview struct {
+	MovieIDs types.Set    `tfsdk:"movie_ids"`
+	Renames  types.Set    `tfsdk:"renames"`
+	ID       types.String `tfsdk:"id"`
+}
+
+// RenameFile is part of RenamePreview.
+type RenameFile struct {
+	ExistingPath types.String `tfsdk:"existing_path"`
+	NewPath      types.String `tfsdk:"new_path"`
+	MovieID      types.Int64  `tfsdk:"movie_id"`
+	MovieFileID  types.Int64  `tfsdk:"movie_file_id"`
+}
+
+func (f RenameFile) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"existing_path": types.StringType,
+			"new_path":      types.StringType,
+			"movie_id":      types.Int64Type,
+			"movie_file_id": types.Int64Type,
+		})
+}
+
+func (d *RenamePreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + renamePreviewDataSourceName
+}
+
+func (d *RenamePreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Movies -->List the files that a [Rename](../resources/rename) would change for the given movies.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"movie_ids": schema.SetAttribute{
+				MarkdownDescription: "Movie IDs.",
+				Required:            true,
+				ElementType:         types.Int64Type,
+			},
+			"renames": schema.SetNestedAttribute{
+				MarkdownDescription: "Rename list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: RenamePreviewDataSource{}.getRenameFileSchema().Attributes,
+				},
+			},
+		},
+	}
+}
+
+func (d RenamePreviewDataSource) getRenameFileSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"movie_id": schema.Int64Attribute{
+				MarkdownDescription: "Movie ID.",
+				Computed:            true,
+			},
+			"movie_file_id": schema.Int64Attribute{
+				MarkdownDescription: "Movie file ID.",
+				Computed:            true,
+			},
+			"existing_path": schema.StringAttribute{
+				MarkdownDescription: "Existing path.",
+				Computed:            true,
+			},
+			"new_path": schema.StringAttribute{
+				MarkdownDescription: "New path.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *RenamePreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *RenamePreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *RenamePreview
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var movieIDs []int64
+
+	resp.Diagnostics.Append(data.MovieIDs.ElementsAs(ctx, &movieIDs, false)...)
+
+	// Get renames current value
+	renames := listRenames(ctx, d.client, movieIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+renamePreviewDataSourceName)
+	// Map response body to resource schema attribute
+	data.Renames = writeRenameFiles(ctx, renames, &resp.Diagnostics)
+	data.ID = types.StringValue(strconv.Itoa(len(renames)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// listRenames collects the pending renames of all the given movies.
+func listRenames(ctx context.Context, client *helpers.Client, movieIDs []int64, diags *diag.Diagnostics) []*whisparr.RenameMovieResource {
+	var renames []*whisparr.RenameMovieResource
+
+	for _, id := range movieIDs {
+		response, _, err := client.RenameMovieApi.ListRename(ctx).MovieId(int32(id)).Execute()
+		if err != nil {
+			diags.Append(helpers.ParseClientDiagnostics(helpers.List, renamePreviewDataSourceName, err)...)
+
+			return nil
+		}
+
+		renames = append(renames, response...)
+	}
+
+	return renames
+}
+
+func writeRenameFiles(ctx context.Context, renames []*whisparr.RenameMovieResource, diags *diag.Diagnostics) types.Set {
+	files := make([]RenameFile, len(renames))
+	for i, r := range renames {
+		files[i].write(r)
+	}
+
+	set, tempDiag := types.SetValueFrom(ctx, RenameFile{}.getType(), files)
+	diags.Append(tempDiag...)
+
+	return set
+}
+
+func (f *RenameFile) write(rename *whisparr.RenameMovieResource) {
+	f.MovieID = types.Int64Value(int64(rename.GetMovieId()))
+	f.MovieFileID = types.Int64Value(int64(rename.GetMovieFileId()))
+	f.ExistingPath = types.StringValue(rename.GetExistingPath())
+	f.NewPath = types.StringValue(rename.GetNewPath())
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRenamePreviewDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccRenamePreviewDataSourceConfig("[1]") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccRenamePreviewDataSourceConfig("data.whisparr_movies.test.ids"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.whisparr_rename_preview.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRenamePreviewDataSourceConfig(ids string) string {
+	return fmt.Sprintf(`
+	data "whisparr_movies" "test" {
+		ids_only = true
+	}
+
+	data "whisparr_rename_preview" "test" {
+		movie_ids = %s
+	}
+	`, ids)
+}
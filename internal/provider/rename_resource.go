@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	renameResourceName = "rename"
+	renameCommandName  = "RenameMovie"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RenameResource{}
+
+func NewRenameResource() resource.Resource {
+	return &RenameResource{}
+}
+
+// RenameResource defines the rename implementation.
+type RenameResource struct {
+	client *helpers.Client
+}
+
+// Rename describes the rename data model.
+type Rename struct {
+	MovieIDs types.Set    `tfsdk:"movie_ids"`
+	Renames  types.Set    `tfsdk:"renames"`
+	Status   types.String `tfsdk:"status"`
+	ID       types.Int64  `tfsdk:"id"`
+}
+
+// renameCommand is the RenameMovie command payload, not modeled by the SDK.
+type renameCommand struct {
+	Name     string  `json:"name"`
+	MovieIDs []int64 `json:"movieIds"`
+}
+
+func (r *RenameResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + renameResourceName
+}
+
+func (r *RenameResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Movies -->Rename resource.\nRenames the existing files of the given movies according to the current [Naming](../resources/naming) and waits for completion.\n" +
+			"The applied changes are recorded in `renames`, see [Rename Preview](../data-sources/rename_preview) to review them beforehand.\n" +
+			"Any change triggers a new rename, destroying the resource only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"movie_ids": schema.SetAttribute{
+				MarkdownDescription: "Movie IDs.",
+				Required:            true,
+				ElementType:         types.Int64Type,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Rename command ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Rename command status.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"renames": schema.SetNestedAttribute{
+				MarkdownDescription: "Renamed files.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: r.getRenameFileSchema().Attributes,
+				},
+			},
+		},
+	}
+}
+
+func (r RenameResource) getRenameFileSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"movie_id": schema.Int64Attribute{
+				MarkdownDescription: "Movie ID.",
+				Computed:            true,
+			},
+			"movie_file_id": schema.Int64Attribute{
+				MarkdownDescription: "Movie file ID.",
+				Computed:            true,
+			},
+			"existing_path": schema.StringAttribute{
+				MarkdownDescription: "Previous path.",
+				Computed:            true,
+			},
+			"new_path": schema.StringAttribute{
+				MarkdownDescription: "New path.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *RenameResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *RenameResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var rename *Rename
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &rename)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := renameCommand{Name: renameCommandName}
+	resp.Diagnostics.Append(rename.MovieIDs.ElementsAs(ctx, &request.MovieIDs, false)...)
+
+	// Record pending renames before executing them
+	renames := listRenames(ctx, r.client, request.MovieIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Send RenameMovie command
+	response, err := r.client.SendCommand(ctx, request)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Create, renameResourceName, err)...)
+
+		return
+	}
+
+	response, err = r.client.WaitCommand(ctx, response.GetId(), helpers.CommandTimeout)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Create, renameResourceName, err)...)
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+renameResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	rename.ID = types.Int64Value(int64(response.GetId()))
+	rename.Status = types.StringValue(string(response.GetStatus()))
+	rename.Renames = writeRenameFiles(ctx, renames, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &rename)...)
+}
+
+// Commands are purged by Whisparr, state is kept as is.
+func (r *RenameResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var rename *Rename
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &rename)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+renameResourceName+": "+strconv.Itoa(int(rename.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &rename)...)
+}
+
+// never used.
+func (r *RenameResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r *RenameResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Renamed files are not reverted
+	tflog.Trace(ctx, "decoupled "+renameResourceName)
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRenameResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccRenameResourceConfig("[1]") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccRenameResourceConfig("data.whisparr_movies.test.ids"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("whisparr_rename.test", "status", "completed"),
+					resource.TestCheckResourceAttrSet("whisparr_rename.test", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRenameResourceConfig(ids string) string {
+	return fmt.Sprintf(`
+	data "whisparr_movies" "test" {
+		ids_only = true
+	}
+
+	resource "whisparr_rename" "test" {
+		movie_ids = %s
+	}
+	`, ids)
+}
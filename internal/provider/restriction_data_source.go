@@ -24,7 +24,7 @@ func NewRestrictionDataSource() datasource.DataSource {
 
 // RestrictionDataSource defines the remote path restriction implementation.
 type RestrictionDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *RestrictionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -49,6 +49,11 @@ func (d *RestrictionDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Restriction ID.",
 				Required:            true,
@@ -74,12 +79,13 @@ func (d *RestrictionDataSource) Read(ctx context.Context, req datasource.ReadReq
 	// Get remote path restriction current value
 	response, _, err := d.client.RestrictionApi.ListRestriction(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, restrictionDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, restrictionDataSourceName, err)...)
 
 		return
 	}
 
 	data.find(ctx, data.ID.ValueInt64(), response, &resp.Diagnostics)
+	data.TagLabels = d.client.TagLabels(ctx, data.Tags, &resp.Diagnostics)
 	tflog.Trace(ctx, "read "+restrictionDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -21,8 +23,9 @@ const restrictionName = "restriction"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &RestrictionResource{}
-	_ resource.ResourceWithImportState = &RestrictionResource{}
+	_ resource.Resource                 = &RestrictionResource{}
+	_ resource.ResourceWithImportState  = &RestrictionResource{}
+	_ resource.ResourceWithUpgradeState = &RestrictionResource{}
 )
 
 func NewRestrictionResource() resource.Resource {
@@ -31,30 +34,33 @@ func NewRestrictionResource() resource.Resource {
 
 // RestrictionResource defines the remote path restriction implementation.
 type RestrictionResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // Restriction describes the remote path restriction data model.
 type Restriction struct {
-	Tags     types.Set    `tfsdk:"tags"`
-	Required types.String `tfsdk:"required"`
-	Ignored  types.String `tfsdk:"ignored"`
-	ID       types.Int64  `tfsdk:"id"`
+	Tags      types.Set    `tfsdk:"tags"`
+	TagLabels types.Set    `tfsdk:"tag_labels"`
+	Required  types.String `tfsdk:"required"`
+	Ignored   types.String `tfsdk:"ignored"`
+	ID        types.Int64  `tfsdk:"id"`
 }
 
 func (r Restriction) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
-			"id":       types.Int64Type,
-			"required": types.StringType,
-			"ignored":  types.StringType,
-			"tags":     types.SetType{}.WithElementType(types.Int64Type),
+			"id":         types.Int64Type,
+			"required":   types.StringType,
+			"ignored":    types.StringType,
+			"tags":       types.SetType{}.WithElementType(types.Int64Type),
+			"tag_labels": types.SetType{}.WithElementType(types.StringType),
 		})
 }
 
 func (r *RestrictionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->Restriction resource.\nFor more information refer to [Restriction](https://wiki.servarr.com/whisparr/settings#remote-path-restrictions) documentation.",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Restriction ID.",
@@ -79,6 +85,15 @@ func (r *RestrictionResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels": schema.SetAttribute{
+				MarkdownDescription: "List of associated tag labels, resolved to IDs at apply time. Conflicts with `tags`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("tags")),
+				},
+			},
 		},
 	}
 }
@@ -87,6 +102,12 @@ func (r *RestrictionResource) Metadata(_ context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_" + restrictionName
 }
 
+func (r *RestrictionResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *RestrictionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -103,12 +124,18 @@ func (r *RestrictionResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, restriction.TagLabels, &restriction.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new Restriction
 	request := restriction.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.RestrictionApi.CreateRestriction(ctx).RestrictionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, restrictionName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Create, restrictionName, err)...)
 
 		return
 	}
@@ -116,6 +143,7 @@ func (r *RestrictionResource) Create(ctx context.Context, req resource.CreateReq
 	tflog.Trace(ctx, "created "+restrictionName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	restriction.write(ctx, response, &resp.Diagnostics)
+	restriction.TagLabels = r.client.TagLabels(ctx, restriction.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &restriction)...)
 }
 
@@ -130,9 +158,11 @@ func (r *RestrictionResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Get restriction current value
-	response, _, err := r.client.RestrictionApi.GetRestrictionById(ctx, int32(restriction.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.RestrictionApi.GetRestrictionById(ctx, int32(restriction.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, restrictionName, err))
+		if !helpers.RemoveNotFound(ctx, restrictionName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, restrictionName, err)...)
+		}
 
 		return
 	}
@@ -140,6 +170,7 @@ func (r *RestrictionResource) Read(ctx context.Context, req resource.ReadRequest
 	tflog.Trace(ctx, "read "+restrictionName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	restriction.write(ctx, response, &resp.Diagnostics)
+	restriction.TagLabels = r.client.TagLabels(ctx, restriction.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &restriction)...)
 }
 
@@ -153,12 +184,18 @@ func (r *RestrictionResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	r.client.ResolveTagLabels(ctx, restriction.TagLabels, &restriction.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update Restriction
 	request := restriction.read(ctx, &resp.Diagnostics)
 
 	response, _, err := r.client.RestrictionApi.UpdateRestriction(ctx, strconv.Itoa(int(request.GetId()))).RestrictionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, restrictionName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Update, restrictionName, err)...)
 
 		return
 	}
@@ -166,6 +203,7 @@ func (r *RestrictionResource) Update(ctx context.Context, req resource.UpdateReq
 	tflog.Trace(ctx, "updated "+restrictionName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	restriction.write(ctx, response, &resp.Diagnostics)
+	restriction.TagLabels = r.client.TagLabels(ctx, restriction.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &restriction)...)
 }
 
@@ -181,7 +219,7 @@ func (r *RestrictionResource) Delete(ctx context.Context, req resource.DeleteReq
 	// Delete restriction current value
 	_, err := r.client.RestrictionApi.DeleteRestriction(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, restrictionName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Delete, restrictionName, err)...)
 
 		return
 	}
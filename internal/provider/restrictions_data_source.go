@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,7 +22,7 @@ func NewRestrictionsDataSource() datasource.DataSource {
 
 // RestrictionsDataSource defines the restrictions implementation.
 type RestrictionsDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // Restrictions describes the restrictions data model.
@@ -63,6 +62,11 @@ func (d *RestrictionsDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"tag_labels": schema.SetAttribute{
+							MarkdownDescription: "List of associated tag labels.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 						"id": schema.Int64Attribute{
 							MarkdownDescription: "Restriction ID.",
 							Computed:            true,
@@ -84,16 +88,19 @@ func (d *RestrictionsDataSource) Read(ctx context.Context, _ datasource.ReadRequ
 	// Get restrictions current value
 	response, _, err := d.client.RestrictionApi.ListRestriction(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, restrictionsDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.List, restrictionsDataSourceName, err)...)
 
 		return
 	}
 
 	tflog.Trace(ctx, "read "+restrictionsDataSourceName)
+	labels := d.client.TagLabelMap(ctx, &resp.Diagnostics)
+
 	// Map response body to resource schema attribute
 	restrictions := make([]Restriction, len(response))
 	for i, p := range response {
 		restrictions[i].write(ctx, p, &resp.Diagnostics)
+		restrictions[i].TagLabels = helpers.MapTagLabels(ctx, restrictions[i].Tags, labels, &resp.Diagnostics)
 	}
 
 	restrictionList, diags := types.SetValueFrom(ctx, Restriction{}.getType(), restrictions)
@@ -22,7 +22,7 @@ func NewRootFolderDataSource() datasource.DataSource {
 
 // RootFolderDataSource defines the root folders implementation.
 type RootFolderDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *RootFolderDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -83,7 +83,7 @@ func (d *RootFolderDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	// Get rootfolders current value
 	response, _, err := d.client.RootFolderApi.ListRootFolder(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, rootFolderDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, rootFolderDataSourceName, err)...)
 
 		return
 	}
@@ -0,0 +1,349 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
+	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	rootFolderImportResourceName = "root_folder_import"
+	rootFolderImportModeAuto     = "auto"
+	rootFolderImportModeMapping  = "mapping"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RootFolderImportResource{}
+
+func NewRootFolderImportResource() resource.Resource {
+	return &RootFolderImportResource{}
+}
+
+// RootFolderImportResource defines the root folder import implementation.
+type RootFolderImportResource struct {
+	client *helpers.Client
+}
+
+// RootFolderImport describes the root folder import data model.
+type RootFolderImport struct {
+	UnmatchedFolders    types.Set    `tfsdk:"unmatched_folders"`
+	Mapping             types.Map    `tfsdk:"mapping"`
+	ImportedMovies      types.Map    `tfsdk:"imported_movies"`
+	Mode                types.String `tfsdk:"mode"`
+	MinimumAvailability types.String `tfsdk:"minimum_availability"`
+	ID                  types.Int64  `tfsdk:"id"`
+	RootFolderID        types.Int64  `tfsdk:"root_folder_id"`
+	QualityProfileID    types.Int64  `tfsdk:"quality_profile_id"`
+	Monitored           types.Bool   `tfsdk:"monitored"`
+}
+
+func (r *RootFolderImportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + rootFolderImportResourceName
+}
+
+func (r *RootFolderImportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Media Management -->Root Folder Import resource.\nImports the unmapped folders of a root folder as movies, either matching them automatically by name or through an explicit mapping.\n" +
+			"Folders that cannot be matched are reported in `unmatched_folders` for manual follow-up. Updating the resource retries the import on the folders still unmapped.\n" +
+			"Destroying the resource only removes it from the state, imported movies are left untouched.\nFor more information refer to [Root Folders](https://wiki.servarr.com/whisparr/settings#root-folders) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"root_folder_id": schema.Int64Attribute{
+				MarkdownDescription: "Root Folder ID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Import mode. `auto` uses the `mapping` entry of each folder when defined and falls back to looking it up by name, `mapping` imports only the mapped folders. Defaults to `auto`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(rootFolderImportModeAuto, rootFolderImportModeMapping),
+				},
+			},
+			"mapping": schema.MapAttribute{
+				MarkdownDescription: "Map of folder names to TMDB IDs.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Quality profile ID of imported movies.",
+				Required:            true,
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag of imported movies.",
+				Required:            true,
+			},
+			"minimum_availability": schema.StringAttribute{
+				MarkdownDescription: "Minimum availability of imported movies.\nAllowed values: 'tba', 'announced', 'inCinemas', 'released', 'deleted'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("tba", "announced", "inCinemas", "released", "deleted"),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Root Folder Import ID, same as the root folder ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"imported_movies": schema.MapAttribute{
+				MarkdownDescription: "Map of imported folder names to movie IDs.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"unmatched_folders": schema.SetNestedAttribute{
+				MarkdownDescription: "List of folders still not associated to any movie.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: RootFolderResource{}.getUnmappedFolderSchema().Attributes,
+				},
+			},
+		},
+	}
+}
+
+func (r *RootFolderImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+	}
+}
+
+func (r *RootFolderImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var folderImport *RootFolderImport
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &folderImport)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Import unmapped folders
+	r.importFolders(ctx, folderImport, map[string]int64{}, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+rootFolderImportResourceName+": "+strconv.Itoa(int(folderImport.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &folderImport)...)
+}
+
+func (r *RootFolderImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var folderImport *RootFolderImport
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &folderImport)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get rootFolder current value
+	response, httpResp, err := r.client.RootFolderApi.GetRootFolderById(ctx, int32(folderImport.RootFolderID.ValueInt64())).Execute()
+	if err != nil {
+		if !helpers.RemoveNotFound(ctx, rootFolderImportResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, rootFolderImportResourceName, err)...)
+		}
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+rootFolderImportResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	folderImport.writeUnmatched(ctx, response.GetUnmappedFolders(), &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &folderImport)...)
+}
+
+func (r *RootFolderImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan and state values
+	var (
+		folderImport *RootFolderImport
+		state        *RootFolderImport
+	)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &folderImport)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retry import keeping the already imported movies
+	imported := make(map[string]int64, len(state.ImportedMovies.Elements()))
+	if !state.ImportedMovies.IsNull() {
+		resp.Diagnostics.Append(state.ImportedMovies.ElementsAs(ctx, &imported, false)...)
+	}
+
+	r.importFolders(ctx, folderImport, imported, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+rootFolderImportResourceName+": "+strconv.Itoa(int(folderImport.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &folderImport)...)
+}
+
+func (r *RootFolderImportResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Imported movies are not removed
+	tflog.Trace(ctx, "decoupled "+rootFolderImportResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+// importFolders adds a movie for each matched unmapped folder of the root folder.
+// Folders failing lookup or creation are reported as warnings and left unmatched.
+func (r *RootFolderImportResource) importFolders(ctx context.Context, folderImport *RootFolderImport, imported map[string]int64, diags *diag.Diagnostics) {
+	if folderImport.Mode.IsNull() || folderImport.Mode.IsUnknown() {
+		folderImport.Mode = types.StringValue(rootFolderImportModeAuto)
+	}
+
+	mapping := make(map[string]int64, len(folderImport.Mapping.Elements()))
+	if !folderImport.Mapping.IsNull() {
+		diags.Append(folderImport.Mapping.ElementsAs(ctx, &mapping, false)...)
+	}
+
+	folder, _, err := r.client.RootFolderApi.GetRootFolderById(ctx, int32(folderImport.RootFolderID.ValueInt64())).Execute()
+	if err != nil {
+		diags.Append(helpers.ParseClientDiagnostics(helpers.Read, rootFolderResourceName, err)...)
+
+		return
+	}
+
+	for _, f := range folder.GetUnmappedFolders() {
+		movie := r.match(ctx, folderImport.Mode.ValueString(), f.GetName(), mapping, diags)
+		if movie == nil {
+			continue
+		}
+
+		movie.SetPath(f.GetPath())
+		movie.SetRootFolderPath(folder.GetPath())
+		movie.SetQualityProfileId(int32(folderImport.QualityProfileID.ValueInt64()))
+		movie.SetMonitored(folderImport.Monitored.ValueBool())
+
+		if !folderImport.MinimumAvailability.IsNull() {
+			movie.SetMinimumAvailability(whisparr.MovieStatusType(folderImport.MinimumAvailability.ValueString()))
+		}
+
+		response, _, createErr := r.client.MovieApi.CreateMovie(ctx).MovieResource(*movie).Execute()
+		if createErr != nil {
+			diags.AddWarning(helpers.ClientError, helpers.ParseClientError(helpers.Create, movieResourceName, createErr))
+
+			continue
+		}
+
+		tflog.Trace(ctx, "imported "+movieResourceName+": "+strconv.Itoa(int(response.GetId())))
+		imported[f.GetName()] = int64(response.GetId())
+	}
+
+	// Refresh root folder to collect remaining unmapped folders
+	folder, _, err = r.client.RootFolderApi.GetRootFolderById(ctx, int32(folderImport.RootFolderID.ValueInt64())).Execute()
+	if err != nil {
+		diags.Append(helpers.ParseClientDiagnostics(helpers.Read, rootFolderResourceName, err)...)
+
+		return
+	}
+
+	var tempDiag diag.Diagnostics
+
+	folderImport.ID = types.Int64Value(int64(folder.GetId()))
+	folderImport.ImportedMovies, tempDiag = types.MapValueFrom(ctx, types.Int64Type, imported)
+	diags.Append(tempDiag...)
+	folderImport.writeUnmatched(ctx, folder.GetUnmappedFolders(), diags)
+}
+
+// match returns the movie to be added for the given folder, nil if no match is found.
+func (r *RootFolderImportResource) match(ctx context.Context, mode, name string, mapping map[string]int64, diags *diag.Diagnostics) *whisparr.MovieResource {
+	var (
+		movies []whisparr.MovieResource
+		err    error
+	)
+
+	if tmdbID, ok := mapping[name]; ok {
+		var movie whisparr.MovieResource
+
+		movie, err = r.lookupTmdb(ctx, tmdbID)
+		movies = []whisparr.MovieResource{movie}
+	} else {
+		if mode == rootFolderImportModeMapping {
+			return nil
+		}
+
+		movies, err = r.lookup(ctx, name)
+	}
+
+	if err != nil {
+		diags.AddWarning(helpers.ClientError, helpers.ParseClientError(helpers.Read, "movie lookup for folder "+name, err))
+
+		return nil
+	}
+
+	return firstMatch(movies)
+}
+
+// firstMatch returns the top lookup result, nil if it is already in library or has no TMDB ID.
+// Further results are never used, since they are likely different titles.
+func firstMatch(movies []whisparr.MovieResource) *whisparr.MovieResource {
+	if len(movies) == 0 || movies[0].GetTmdbId() == 0 || movies[0].GetId() != 0 {
+		return nil
+	}
+
+	return &movies[0]
+}
+
+func (r *RootFolderImportResource) lookup(ctx context.Context, term string) ([]whisparr.MovieResource, error) {
+	response, err := r.client.MovieLookupApi.GetMovieLookup(ctx).Term(term).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	var movies []whisparr.MovieResource
+
+	err = json.NewDecoder(response.Body).Decode(&movies)
+
+	return movies, err
+}
+
+func (r *RootFolderImportResource) lookupTmdb(ctx context.Context, tmdbID int64) (whisparr.MovieResource, error) {
+	var movie whisparr.MovieResource
+
+	response, err := r.client.MovieLookupApi.GetMovieLookupTmdb(ctx).TmdbId(int32(tmdbID)).Execute()
+	if err != nil {
+		return movie, err
+	}
+
+	defer response.Body.Close()
+
+	err = json.NewDecoder(response.Body).Decode(&movie)
+
+	return movie, err
+}
+
+func (r *RootFolderImport) writeUnmatched(ctx context.Context, folders []*whisparr.UnmappedFolder, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	unmatched := make([]Path, len(folders))
+	for i, f := range folders {
+		unmatched[i].write(f)
+	}
+
+	r.UnmatchedFolders, tempDiag = types.SetValueFrom(ctx, Path{}.getType(), unmatched)
+	diags.Append(tempDiag...)
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFirstMatch(t *testing.T) {
+	t.Parallel()
+
+	movie := func(id, tmdbID int32) whisparr.MovieResource {
+		m := *whisparr.NewMovieResource()
+		m.SetId(id)
+		m.SetTmdbId(tmdbID)
+
+		return m
+	}
+
+	tests := map[string]struct {
+		movies   []whisparr.MovieResource
+		expected int32
+	}{
+		"first": {
+			movies:   []whisparr.MovieResource{movie(0, 10), movie(0, 20)},
+			expected: 10,
+		},
+		"first in library": {
+			movies: []whisparr.MovieResource{movie(1, 10), movie(0, 20)},
+		},
+		"first without tmdb id": {
+			movies: []whisparr.MovieResource{movie(0, 0), movie(0, 20)},
+		},
+		"empty": {
+			movies: []whisparr.MovieResource{},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match := firstMatch(test.movies)
+			if test.expected == 0 {
+				assert.Nil(t, match)
+
+				return
+			}
+
+			assert.Equal(t, test.expected, match.GetTmdbId())
+		})
+	}
+}
+
+func TestAccRootFolderImportResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized create
+			{
+				Config:      testAccRootFolderImportResourceConfig("0", "false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccRootFolderResourceConfig("/config") + testAccRootFolderImportResourceConfig("whisparr_root_folder.test.id", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("whisparr_root_folder_import.test", "mode", "mapping"),
+					resource.TestCheckResourceAttr("whisparr_root_folder_import.test", "imported_movies.%", "0"),
+					resource.TestCheckResourceAttrPair("whisparr_root_folder_import.test", "id", "whisparr_root_folder.test", "id"),
+				),
+			},
+			// Unauthorized read
+			{
+				Config:      testAccRootFolderImportResourceConfig("0", "false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccRootFolderResourceConfig("/config") + testAccRootFolderImportResourceConfig("whisparr_root_folder.test.id", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("whisparr_root_folder_import.test", "monitored", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRootFolderImportResourceConfig(folder, monitored string) string {
+	return fmt.Sprintf(`
+		resource "whisparr_root_folder_import" "test" {
+			root_folder_id = %s
+			mode = "mapping"
+			quality_profile_id = 1
+			monitored = %s
+		}
+	`, folder, monitored)
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
 	"github.com/devopsarr/whisparr-go/whisparr"
@@ -22,8 +23,9 @@ const rootFolderResourceName = "root_folder"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &RootFolderResource{}
-	_ resource.ResourceWithImportState = &RootFolderResource{}
+	_ resource.Resource                 = &RootFolderResource{}
+	_ resource.ResourceWithImportState  = &RootFolderResource{}
+	_ resource.ResourceWithUpgradeState = &RootFolderResource{}
 )
 
 func NewRootFolderResource() resource.Resource {
@@ -32,7 +34,7 @@ func NewRootFolderResource() resource.Resource {
 
 // RootFolderResource defines the root folder implementation.
 type RootFolderResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // RootFolder describes the root folder data model.
@@ -43,6 +45,15 @@ type RootFolder struct {
 	Accessible      types.Bool   `tfsdk:"accessible"`
 }
 
+// ManagedRootFolder describes the root folder resource data model.
+type ManagedRootFolder struct {
+	UnmappedFolders types.Set    `tfsdk:"unmapped_folders"`
+	Path            types.String `tfsdk:"path"`
+	ID              types.Int64  `tfsdk:"id"`
+	Accessible      types.Bool   `tfsdk:"accessible"`
+	AdoptExisting   types.Bool   `tfsdk:"adopt_existing"`
+}
+
 func (r RootFolder) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -74,6 +85,7 @@ func (r *RootFolderResource) Metadata(_ context.Context, req resource.MetadataRe
 func (r *RootFolderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Media Management -->Root Folder resource.\nFor more information refer to [Root Folders](https://wiki.servarr.com/whisparr/settings#root-folders) documentation.",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			// TODO: add validator
 			"path": schema.StringAttribute{
@@ -87,6 +99,10 @@ func (r *RootFolderResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				MarkdownDescription: "Access flag.",
 				Computed:            true,
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Adopt an existing root folder with the same path instead of failing on create. Defaults to the provider `adopt_existing` value.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Root Folder ID.",
 				Computed:            true,
@@ -120,6 +136,12 @@ func (r RootFolderResource) getUnmappedFolderSchema() schema.Schema {
 	}
 }
 
+func (r *RootFolderResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *RootFolderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -128,7 +150,7 @@ func (r *RootFolderResource) Configure(ctx context.Context, req resource.Configu
 
 func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var folder *RootFolder
+	var folder *ManagedRootFolder
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &folder)...)
 
@@ -136,13 +158,27 @@ func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	// Adopt existing RootFolder
+	existing := r.find(ctx, folder, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if existing != nil {
+		tflog.Trace(ctx, "adopted "+rootFolderResourceName+": "+strconv.Itoa(int(existing.GetId())))
+		folder.write(ctx, existing, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &folder)...)
+
+		return
+	}
+
 	// Create new RootFolder
 	request := *whisparr.NewRootFolderResource()
 	request.SetPath(folder.Path.ValueString())
 
 	response, _, err := r.client.RootFolderApi.CreateRootFolder(ctx).RootFolderResource(request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, rootFolderResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Create, rootFolderResourceName, err)...)
 
 		return
 	}
@@ -155,7 +191,7 @@ func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequ
 
 func (r *RootFolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var folder *RootFolder
+	var folder *ManagedRootFolder
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &folder)...)
 
@@ -164,9 +200,11 @@ func (r *RootFolderResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Get rootFolder current value
-	response, _, err := r.client.RootFolderApi.GetRootFolderById(ctx, int32(folder.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.RootFolderApi.GetRootFolderById(ctx, int32(folder.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, rootFolderResourceName, err))
+		if !helpers.RemoveNotFound(ctx, rootFolderResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, rootFolderResourceName, err)...)
+		}
 
 		return
 	}
@@ -193,7 +231,7 @@ func (r *RootFolderResource) Delete(ctx context.Context, req resource.DeleteRequ
 	// Delete rootFolder current value
 	_, err := r.client.RootFolderApi.DeleteRootFolder(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, rootFolderResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Delete, rootFolderResourceName, err)...)
 
 		return
 	}
@@ -207,6 +245,28 @@ func (r *RootFolderResource) ImportState(ctx context.Context, req resource.Impor
 	tflog.Trace(ctx, "imported "+rootFolderResourceName+": "+req.ID)
 }
 
+// find returns the root folder with the same path, if it has to be adopted.
+func (r *RootFolderResource) find(ctx context.Context, folder *ManagedRootFolder, diags *diag.Diagnostics) *whisparr.RootFolderResource {
+	if !r.client.Adopt(folder.AdoptExisting) {
+		return nil
+	}
+
+	folders, _, err := r.client.RootFolderApi.ListRootFolder(ctx).Execute()
+	if err != nil {
+		diags.Append(helpers.ParseClientDiagnostics(helpers.List, rootFolderResourceName, err)...)
+
+		return nil
+	}
+
+	for _, f := range folders {
+		if samePath(f.GetPath(), folder.Path.ValueString()) {
+			return f
+		}
+	}
+
+	return nil
+}
+
 func (r *RootFolder) write(ctx context.Context, rootFolder *whisparr.RootFolderResource, diags *diag.Diagnostics) {
 	var tempDiag diag.Diagnostics
 
@@ -223,6 +283,26 @@ func (r *RootFolder) write(ctx context.Context, rootFolder *whisparr.RootFolderR
 	diags.Append(tempDiag...)
 }
 
+// write keeps the configured path when it differs from the API one only by a trailing slash.
+func (r *ManagedRootFolder) write(ctx context.Context, rootFolder *whisparr.RootFolderResource, diags *diag.Diagnostics) {
+	var folder RootFolder
+
+	folder.write(ctx, rootFolder, diags)
+
+	if r.Path.IsNull() || !samePath(r.Path.ValueString(), folder.Path.ValueString()) {
+		r.Path = folder.Path
+	}
+
+	r.UnmappedFolders = folder.UnmappedFolders
+	r.ID = folder.ID
+	r.Accessible = folder.Accessible
+}
+
+// samePath compares two paths ignoring the trailing slash.
+func samePath(a, b string) bool {
+	return strings.TrimRight(a, "/") == strings.TrimRight(b, "/")
+}
+
 func (p *Path) write(folder *whisparr.UnmappedFolder) {
 	p.Name = types.StringValue(folder.GetName())
 	p.Path = types.StringValue(folder.GetPath())
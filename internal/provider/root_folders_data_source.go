@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,7 +22,7 @@ func NewRootFoldersDataSource() datasource.DataSource {
 
 // RootFoldersDataSource defines the root folders implementation.
 type RootFoldersDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // RootFolders describes the root folders data model.
@@ -95,7 +94,7 @@ func (d *RootFoldersDataSource) Read(ctx context.Context, _ datasource.ReadReque
 	// Get rootfolders current value
 	response, _, err := d.client.RootFolderApi.ListRootFolder(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, rootFoldersDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.List, rootFoldersDataSourceName, err)...)
 
 		return
 	}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStateUpgrade upgrades the recorded states of each resource to its current schema version.
+// Fixtures are recorded with the last release of each schema version and stored in testdata/states/<resource type>/v<version>.json.
+func TestStateUpgrade(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := providerserver.NewProtocol6(New("test")())()
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+
+	for typeName, schema := range schemas.ResourceSchemas {
+		typeName, schema := typeName, schema
+
+		t.Run(typeName, func(t *testing.T) {
+			t.Parallel()
+
+			for version := int64(0); version < schema.Version; version++ {
+				data, err := os.ReadFile(filepath.Join("testdata", "states", typeName, "v"+strconv.FormatInt(version, 10)+".json"))
+				require.NoError(t, err, "missing state fixture for version %d", version)
+
+				resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+					TypeName: typeName,
+					Version:  version,
+					RawState: &tfprotov6.RawState{JSON: data},
+				})
+				require.NoError(t, err)
+
+				for _, d := range resp.Diagnostics {
+					assert.NotEqual(t, tfprotov6.DiagnosticSeverityError, d.Severity, "%s: %s", d.Summary, d.Detail)
+				}
+
+				require.NotNil(t, resp.UpgradedState, "version %d", version)
+
+				state, err := resp.UpgradedState.Unmarshal(schema.ValueType())
+				require.NoError(t, err)
+
+				var upgraded map[string]tftypes.Value
+
+				require.NoError(t, state.As(&upgraded))
+				assertFixtureValues(t, schema.ValueType(), data, upgraded)
+			}
+		})
+	}
+}
+
+// TestStateUpgradeFixtures ensures each fixture belongs to a prior version of an existing resource.
+func TestStateUpgradeFixtures(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := providerserver.NewProtocol6(New("test")())()
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "states", "*", "v*.json"))
+	require.NoError(t, err)
+
+	for _, fixture := range fixtures {
+		typeName := filepath.Base(filepath.Dir(fixture))
+		schema, ok := schemas.ResourceSchemas[typeName]
+		require.True(t, ok, "fixture %s has no resource", fixture)
+
+		version, err := strconv.ParseInt(filepath.Base(fixture)[1:len(filepath.Base(fixture))-len(".json")], 10, 64)
+		require.NoError(t, err)
+		assert.Less(t, version, schema.Version, "fixture %s is not a prior version", fixture)
+	}
+}
+
+// assertFixtureValues checks that every attribute recorded in the fixture survived the upgrade unchanged.
+func assertFixtureValues(t *testing.T, schemaType tftypes.Type, data []byte, upgraded map[string]tftypes.Value) {
+	t.Helper()
+
+	var recorded map[string]json.RawMessage
+
+	require.NoError(t, json.Unmarshal(data, &recorded))
+	require.Contains(t, recorded, "id", "fixture without id")
+
+	attributes := schemaType.(tftypes.Object).AttributeTypes
+
+	for name, raw := range recorded {
+		attributeType, ok := attributes[name]
+		if !ok {
+			continue
+		}
+
+		expected, err := tftypes.ValueFromJSON(raw, attributeType)
+		require.NoError(t, err, name)
+		assert.True(t, expected.Equal(upgraded[name]), "%s: expected %s, got %s", name, expected, upgraded[name])
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -22,7 +21,7 @@ func NewSystemStatusDataSource() datasource.DataSource {
 
 // SystemStatusDataSource defines the system status implementation.
 type SystemStatusDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // SystemStatus describes the system status data model.
@@ -205,7 +204,7 @@ func (d *SystemStatusDataSource) Read(ctx context.Context, req datasource.ReadRe
 	// Get naming current value
 	// response, _, err := d.client.SystemApi.GetSystemStatus(ctx).Execute()
 	// if err != nil {
-	// 	resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, systemStatusDataSourceName, err))
+	// 	resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, systemStatusDataSourceName, err)...)
 
 	// 	return
 	// }
@@ -22,7 +22,7 @@ func NewTagDataSource() datasource.DataSource {
 
 // TagDataSource defines the tag implementation.
 type TagDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 func (d *TagDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -64,7 +64,7 @@ func (d *TagDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	// Get tags current value
 	response, _, err := d.client.TagApi.ListTag(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, tagDataSourceName, err)...)
 
 		return
 	}
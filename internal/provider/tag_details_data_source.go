@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
+	"github.com/devopsarr/whisparr-go/whisparr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const tagDetailsDataSourceName = "tag_details"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TagDetailsDataSource{}
+
+func NewTagDetailsDataSource() datasource.DataSource {
+	return &TagDetailsDataSource{}
+}
+
+// TagDetailsDataSource defines the tag details implementation.
+type TagDetailsDataSource struct {
+	client *helpers.Client
+}
+
+// TagDetails describes the tag details data model.
+type TagDetails struct {
+	DelayProfileIDs   types.Set    `tfsdk:"delay_profile_ids"`
+	NotificationIDs   types.Set    `tfsdk:"notification_ids"`
+	RestrictionIDs    types.Set    `tfsdk:"restriction_ids"`
+	ImportListIDs     types.Set    `tfsdk:"import_list_ids"`
+	IndexerIDs        types.Set    `tfsdk:"indexer_ids"`
+	DownloadClientIDs types.Set    `tfsdk:"download_client_ids"`
+	MovieIDs          types.Set    `tfsdk:"movie_ids"`
+	Label             types.String `tfsdk:"label"`
+	ID                types.Int64  `tfsdk:"id"`
+}
+
+func (t TagDetails) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"delay_profile_ids":   types.SetType{}.WithElementType(types.Int64Type),
+			"notification_ids":    types.SetType{}.WithElementType(types.Int64Type),
+			"restriction_ids":     types.SetType{}.WithElementType(types.Int64Type),
+			"import_list_ids":     types.SetType{}.WithElementType(types.Int64Type),
+			"indexer_ids":         types.SetType{}.WithElementType(types.Int64Type),
+			"download_client_ids": types.SetType{}.WithElementType(types.Int64Type),
+			"movie_ids":           types.SetType{}.WithElementType(types.Int64Type),
+			"label":               types.StringType,
+			"id":                  types.Int64Type,
+		})
+}
+
+func (d *TagDetailsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + tagDetailsDataSourceName
+}
+
+func (d *TagDetailsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Tags -->Single [Tag](../resources/tag) with its usage details.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Tag ID.",
+				Computed:            true,
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "Tag label.",
+				Required:            true,
+			},
+			"delay_profile_ids": schema.SetAttribute{
+				MarkdownDescription: "Delay profile IDs.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"notification_ids": schema.SetAttribute{
+				MarkdownDescription: "Notification IDs.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"restriction_ids": schema.SetAttribute{
+				MarkdownDescription: "Restriction IDs.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"import_list_ids": schema.SetAttribute{
+				MarkdownDescription: "Import list IDs.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"indexer_ids": schema.SetAttribute{
+				MarkdownDescription: "Indexer IDs.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"download_client_ids": schema.SetAttribute{
+				MarkdownDescription: "Download client IDs.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"movie_ids": schema.SetAttribute{
+				MarkdownDescription: "Movie IDs.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+		},
+	}
+}
+
+func (d *TagDetailsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *TagDetailsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *TagDetails
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get tag details current value
+	response, _, err := d.client.TagDetailsApi.ListTagDetail(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, tagDetailsDataSourceName, err)...)
+
+		return
+	}
+
+	// Download clients are not part of tag details and need to be collected separately
+	clients, _, err := d.client.DownloadClientApi.ListDownloadClient(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, tagDetailsDataSourceName, err)...)
+
+		return
+	}
+
+	data.find(ctx, data.Label.ValueString(), response, clients, &resp.Diagnostics)
+	tflog.Trace(ctx, "read "+tagDetailsDataSourceName)
+	// Map response body to resource schema attribute
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (t *TagDetails) find(ctx context.Context, label string, details []*whisparr.TagDetailsResource, clients []*whisparr.DownloadClientResource, diags *diag.Diagnostics) {
+	for _, detail := range details {
+		if detail.GetLabel() == label {
+			t.write(ctx, detail, clients, diags)
+
+			return
+		}
+	}
+
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(tagDetailsDataSourceName, "label", label))
+}
+
+func (t *TagDetails) write(ctx context.Context, details *whisparr.TagDetailsResource, clients []*whisparr.DownloadClientResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	downloadClientIDs := make([]int32, 0)
+
+	for _, c := range clients {
+		for _, tag := range c.GetTags() {
+			if *tag == details.GetId() {
+				downloadClientIDs = append(downloadClientIDs, c.GetId())
+			}
+		}
+	}
+
+	t.ID = types.Int64Value(int64(details.GetId()))
+	t.Label = types.StringValue(details.GetLabel())
+	t.DownloadClientIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, downloadClientIDs)
+	diags.Append(tempDiag...)
+	t.DelayProfileIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, details.GetDelayProfileIds())
+	diags.Append(tempDiag...)
+	t.NotificationIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, details.GetNotificationIds())
+	diags.Append(tempDiag...)
+	t.RestrictionIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, details.GetRestrictionIds())
+	diags.Append(tempDiag...)
+	t.ImportListIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, details.GetImportListIds())
+	diags.Append(tempDiag...)
+	t.IndexerIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, details.GetIndexerIds())
+	diags.Append(tempDiag...)
+	t.MovieIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, details.GetMovieIds())
+	diags.Append(tempDiag...)
+}
+
+// isUsed returns true when the tag is attached to any object.
+func (t *TagDetails) isUsed() bool {
+	for _, ids := range []types.Set{t.DelayProfileIDs, t.NotificationIDs, t.RestrictionIDs, t.ImportListIDs, t.IndexerIDs, t.DownloadClientIDs, t.MovieIDs} {
+		if len(ids.Elements()) != 0 {
+			return true
+		}
+	}
+
+	return false
+}
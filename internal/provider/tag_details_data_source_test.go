@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTagDetailsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccTagDetailsDataSourceConfig("error") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Not found testing
+			{
+				Config:      testAccTagDetailsDataSourceConfig("error"),
+				ExpectError: regexp.MustCompile("Unable to find tag_details"),
+			},
+			// Create a resource be read
+			{
+				Config: testAccTagResourceConfig("test", "tag_details_datasource") + testAccDelayProfileResourceConfig("torrent", "whisparr_tag.test.id"),
+			},
+			// Read testing
+			{
+				Config: testAccTagResourceConfig("test", "tag_details_datasource") + testAccDelayProfileResourceConfig("torrent", "whisparr_tag.test.id") + testAccTagDetailsDataSourceConfig("tag_details_datasource"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.whisparr_tag_details.test", "id"),
+					resource.TestCheckResourceAttr("data.whisparr_tag_details.test", "label", "tag_details_datasource"),
+					resource.TestCheckResourceAttr("data.whisparr_tag_details.test", "delay_profile_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.whisparr_tag_details.test", "movie_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTagDetailsDataSourceConfig(label string) string {
+	return fmt.Sprintf(`
+	data "whisparr_tag_details" "test" {
+		label = "%s"
+	}
+	`, label)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -24,8 +25,9 @@ const tagResourceName = "tag"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &TagResource{}
-	_ resource.ResourceWithImportState = &TagResource{}
+	_ resource.Resource                 = &TagResource{}
+	_ resource.ResourceWithImportState  = &TagResource{}
+	_ resource.ResourceWithUpgradeState = &TagResource{}
 )
 
 func NewTagResource() resource.Resource {
@@ -34,7 +36,7 @@ func NewTagResource() resource.Resource {
 
 // TagResource defines the tag implementation.
 type TagResource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // Tag describes the tag data model.
@@ -43,6 +45,14 @@ type Tag struct {
 	ID    types.Int64  `tfsdk:"id"`
 }
 
+// ManagedTag describes the tag resource data model.
+type ManagedTag struct {
+	Label                types.String `tfsdk:"label"`
+	ID                   types.Int64  `tfsdk:"id"`
+	PreventDestroyIfUsed types.Bool   `tfsdk:"prevent_destroy_if_used"`
+	AdoptExisting        types.Bool   `tfsdk:"adopt_existing"`
+}
+
 func (t Tag) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -58,6 +68,7 @@ func (r *TagResource) Metadata(_ context.Context, req resource.MetadataRequest,
 func (r *TagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Tags -->Tag resource.\nFor more information refer to [Tags](https://wiki.servarr.com/whisparr/settings#tags) documentation.",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"label": schema.StringAttribute{
 				MarkdownDescription: "Tag label. It must be lowercase.",
@@ -69,6 +80,10 @@ func (r *TagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 					),
 				},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Adopt an existing tag with the same label instead of failing on create. Defaults to the provider `adopt_existing` value.",
+				Optional:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Tag ID.",
 				Computed:            true,
@@ -76,10 +91,20 @@ func (r *TagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"prevent_destroy_if_used": schema.BoolAttribute{
+				MarkdownDescription: "Fail the destroy if the tag is still attached to any object.",
+				Optional:            true,
+			},
 		},
 	}
 }
 
+func (r *TagResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: helpers.AdditiveUpgrader(),
+	}
+}
+
 func (r *TagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if client := helpers.ResourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -88,7 +113,7 @@ func (r *TagResource) Configure(ctx context.Context, req resource.ConfigureReque
 
 func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var tag *Tag
+	var tag *ManagedTag
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &tag)...)
 
@@ -96,17 +121,32 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	// Adopt existing Tag
+	existing := r.find(ctx, tag, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if existing != nil {
+		tflog.Trace(ctx, "adopted tag: "+strconv.Itoa(int(existing.GetId())))
+		tag.write(existing)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &tag)...)
+
+		return
+	}
+
 	// Create new Tag
 	request := *whisparr.NewTagResource()
 	request.SetLabel(tag.Label.ValueString())
 
 	response, _, err := r.client.TagApi.CreateTag(ctx).TagResource(request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, tagResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Create, tagResourceName, err)...)
 
 		return
 	}
 
+	r.client.InvalidateTagLabels()
 	tflog.Trace(ctx, "created tag: "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	tag.write(response)
@@ -115,7 +155,7 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var tag *Tag
+	var tag *ManagedTag
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &tag)...)
 
@@ -124,9 +164,11 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	// Get tag current value
-	response, _, err := r.client.TagApi.GetTagById(ctx, int32(tag.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.TagApi.GetTagById(ctx, int32(tag.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagResourceName, err))
+		if !helpers.RemoveNotFound(ctx, tagResourceName, httpResp, err, &resp.State, &resp.Diagnostics) {
+			resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Read, tagResourceName, err)...)
+		}
 
 		return
 	}
@@ -139,7 +181,7 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var tag *Tag
+	var tag *ManagedTag
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &tag)...)
 
@@ -154,11 +196,12 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	response, _, err := r.client.TagApi.UpdateTag(ctx, fmt.Sprint(tagResource.GetId())).TagResource(tagResource).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, tagResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Update, tagResourceName, err)...)
 
 		return
 	}
 
+	r.client.InvalidateTagLabels()
 	tflog.Trace(ctx, "updated "+tagResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	tag.write(response)
@@ -166,22 +209,33 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 }
 
 func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var tag *ManagedTag
 
-	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &tag)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ID := tag.ID.ValueInt64()
+
+	if tag.PreventDestroyIfUsed.ValueBool() {
+		r.checkUsage(ctx, ID, &resp.Diagnostics)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Delete tag current value
 	_, err := r.client.TagApi.DeleteTag(ctx, int32(ID)).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, tagResourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.Delete, tagResourceName, err)...)
 
 		return
 	}
 
+	r.client.InvalidateTagLabels()
 	tflog.Trace(ctx, "deleted "+tagResourceName+": "+strconv.Itoa(int(ID)))
 	resp.State.RemoveResource(ctx)
 }
@@ -191,7 +245,58 @@ func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateR
 	tflog.Trace(ctx, "imported "+tagResourceName+": "+req.ID)
 }
 
+// checkUsage adds an error if the tag is still attached to any object.
+func (r *TagResource) checkUsage(ctx context.Context, ID int64, diags *diag.Diagnostics) {
+	response, _, err := r.client.TagDetailsApi.GetTagDetailById(ctx, int32(ID)).Execute()
+	if err != nil {
+		diags.Append(helpers.ParseClientDiagnostics(helpers.Read, tagDetailsDataSourceName, err)...)
+
+		return
+	}
+
+	clients, _, err := r.client.DownloadClientApi.ListDownloadClient(ctx).Execute()
+	if err != nil {
+		diags.Append(helpers.ParseClientDiagnostics(helpers.List, tagDetailsDataSourceName, err)...)
+
+		return
+	}
+
+	details := TagDetails{}
+	details.write(ctx, response, clients, diags)
+
+	if details.isUsed() {
+		diags.AddError(helpers.ResourceError, fmt.Sprintf("Unable to %s %s, got error: tag '%s' is still in use", helpers.Delete, tagResourceName, details.Label.ValueString()))
+	}
+}
+
+// find returns the tag with the same label, if it has to be adopted.
+func (r *TagResource) find(ctx context.Context, tag *ManagedTag, diags *diag.Diagnostics) *whisparr.TagResource {
+	if !r.client.Adopt(tag.AdoptExisting) {
+		return nil
+	}
+
+	tags, _, err := r.client.TagApi.ListTag(ctx).Execute()
+	if err != nil {
+		diags.Append(helpers.ParseClientDiagnostics(helpers.List, tagResourceName, err)...)
+
+		return nil
+	}
+
+	for _, t := range tags {
+		if t.GetLabel() == tag.Label.ValueString() {
+			return t
+		}
+	}
+
+	return nil
+}
+
 func (t *Tag) write(tag *whisparr.TagResource) {
 	t.ID = types.Int64Value(int64(tag.GetId()))
 	t.Label = types.StringValue(tag.GetLabel())
 }
+
+func (t *ManagedTag) write(tag *whisparr.TagResource) {
+	t.ID = types.Int64Value(int64(tag.GetId()))
+	t.Label = types.StringValue(tag.GetLabel())
+}
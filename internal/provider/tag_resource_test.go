@@ -1,10 +1,12 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -59,3 +61,77 @@ func testAccTagResourceConfig(name, label string) string {
 		}
 	`, name, label)
 }
+
+func TestAccTagResourcePreventDestroy(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTagResourcePreventDestroyConfig("protected"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("whisparr_tag.test", "label", "protected"),
+					resource.TestCheckResourceAttr("whisparr_tag.test", "prevent_destroy_if_used", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "whisparr_tag.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"prevent_destroy_if_used"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTagResourcePreventDestroyConfig(label string) string {
+	return fmt.Sprintf(`
+		resource "whisparr_tag" "test" {
+  			label = "%s"
+			prevent_destroy_if_used = true
+		}
+	`, label)
+}
+
+func TestAccTagResourceAdoptExisting(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Adopt and Read testing
+			{
+				PreConfig: tagAdoptInit,
+				Config:    testAccTagResourceAdoptConfig("true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("whisparr_tag.test", "label", "adopted"),
+					resource.TestCheckResourceAttrSet("whisparr_tag.test", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func tagAdoptInit() {
+	// ensure an adopted tag exists before create
+	client := testAccAPIClient()
+	tag := whisparr.NewTagResource()
+	tag.SetLabel("adopted")
+	_, _, _ = client.TagApi.CreateTag(context.TODO()).TagResource(*tag).Execute()
+}
+
+func testAccTagResourceAdoptConfig(adopt string) string {
+	return fmt.Sprintf(`
+	resource "whisparr_tag" "test" {
+		label = "adopted"
+		adopt_existing = %s
+	}
+	`, adopt)
+}
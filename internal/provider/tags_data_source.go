@@ -5,7 +5,6 @@ import (
 	"strconv"
 
 	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
-	"github.com/devopsarr/whisparr-go/whisparr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,7 +22,7 @@ func NewTagsDataSource() datasource.DataSource {
 
 // TagsDataSource defines the tags implementation.
 type TagsDataSource struct {
-	client *whisparr.APIClient
+	client *helpers.Client
 }
 
 // Tags describes the tags data model.
@@ -75,7 +74,7 @@ func (d *TagsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, res
 	// Get tags current value
 	response, _, err := d.client.TagApi.ListTag(ctx).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, tagsDataSourceName, err))
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.List, tagsDataSourceName, err)...)
 
 		return
 	}
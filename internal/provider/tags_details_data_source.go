@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/terraform-provider-whisparr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const tagsDetailsDataSourceName = "tags_details"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TagsDetailsDataSource{}
+
+func NewTagsDetailsDataSource() datasource.DataSource {
+	return &TagsDetailsDataSource{}
+}
+
+// TagsDetailsDataSource defines the tags details implementation.
+type TagsDetailsDataSource struct {
+	client *helpers.Client
+}
+
+// TagsDetails describes the tags details data model.
+type TagsDetails struct {
+	TagsDetails types.Set    `tfsdk:"tags_details"`
+	ID          types.String `tfsdk:"id"`
+}
+
+func (d *TagsDetailsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + tagsDetailsDataSourceName
+}
+
+func (d *TagsDetailsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Tags -->List all available [Tags](../resources/tag) with their usage details.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"tags_details": schema.SetNestedAttribute{
+				MarkdownDescription: "Tag details list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Tag ID.",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "Tag label.",
+							Computed:            true,
+						},
+						"delay_profile_ids": schema.SetAttribute{
+							MarkdownDescription: "Delay profile IDs.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"notification_ids": schema.SetAttribute{
+							MarkdownDescription: "Notification IDs.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"restriction_ids": schema.SetAttribute{
+							MarkdownDescription: "Restriction IDs.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"import_list_ids": schema.SetAttribute{
+							MarkdownDescription: "Import list IDs.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"indexer_ids": schema.SetAttribute{
+							MarkdownDescription: "Indexer IDs.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"download_client_ids": schema.SetAttribute{
+							MarkdownDescription: "Download client IDs.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"movie_ids": schema.SetAttribute{
+							MarkdownDescription: "Movie IDs.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TagsDetailsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if client := helpers.DataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+	}
+}
+
+func (d *TagsDetailsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get tags details current value
+	response, _, err := d.client.TagDetailsApi.ListTagDetail(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.List, tagsDetailsDataSourceName, err)...)
+
+		return
+	}
+
+	// Download clients are not part of tag details and need to be collected separately
+	clients, _, err := d.client.DownloadClientApi.ListDownloadClient(ctx).Execute()
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ParseClientDiagnostics(helpers.List, tagsDetailsDataSourceName, err)...)
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+tagsDetailsDataSourceName)
+	// Map response body to resource schema attribute
+	details := make([]TagDetails, len(response))
+	for i, t := range response {
+		details[i].write(ctx, t, clients, &resp.Diagnostics)
+	}
+
+	detailList, diags := types.SetValueFrom(ctx, TagDetails{}.getType(), details)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, TagsDetails{TagsDetails: detailList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTagsDetailsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccTagsDetailsDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create a resource to have a value to check
+			{
+				Config: testAccTagResourceConfig("test-1", "details_one") + testAccTagResourceConfig("test-2", "details_two"),
+			},
+			// Read testing
+			{
+				Config: testAccTagsDetailsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.whisparr_tags_details.test", "tags_details.*", map[string]string{"label": "details_one"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccTagsDetailsDataSourceConfig = `
+data "whisparr_tags_details" "test" {
+}
+`